@@ -8,15 +8,16 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/kubensage/go-common/datastructure"
-	"github.com/kubensage/kubensage-agent/proto/gen"
-
 	"github.com/kubensage/go-common/cli"
 	"github.com/kubensage/go-common/log"
+	"github.com/kubensage/kubensage-agent/pkg/buffer"
 	"github.com/kubensage/kubensage-agent/pkg/cli"
 	"github.com/kubensage/kubensage-agent/pkg/discovery"
+	"github.com/kubensage/kubensage-agent/pkg/health"
 	"github.com/kubensage/kubensage-agent/pkg/metrics"
+	"github.com/kubensage/kubensage-agent/pkg/psi"
 	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
 	"go.uber.org/zap"
 )
 
@@ -28,6 +29,16 @@ const appName = "kubensage-agent"
 // discovers the CRI socket, establishes gRPC connections to the CRI and relay server,
 // and starts a loop that periodically collects and sends system and container metrics.
 //
+// If no container runtime can be found (or --no-cri is set), the agent runs in node-only
+// mode: node metrics are still collected and sent, and CRI discovery is retried in the
+// background unless CRI was explicitly disabled.
+//
+// When PSI triggers are configured, a fired trigger is reported as a node event and, with
+// --psi-trigger-snapshot, takes an out-of-cycle snapshot between two ticks.
+//
+// Container lifecycle events are received from the CRI GetContainerEvents stream in the
+// background, so that containers living shorter than a tick are reported too.
+//
 // The loop continues until an interrupt signal (SIGINT or SIGTERM) is received.
 func main() {
 
@@ -45,6 +56,7 @@ func main() {
 	}(logger)
 
 	agentCfg := agentCfgLoader(logger)
+	utils.ApplyHostRoot(agentCfg.HostRoot)
 	golog.LogStartupInfo(logger, appName, logCfg, agentCfg)
 
 	logger.Info("Connecting to relay", zap.String("relay_address", agentCfg.RelayAddress))
@@ -60,18 +72,27 @@ func main() {
 		cancel()
 	}()
 
-	logger.Info("discovering CRI socket")
-	criSocket, err := discovery.CriSocketDiscovery()
-	if err != nil {
-		logger.Fatal("CRI socket discovery failed", zap.Error(err))
+	criConnector := discovery.NewCriConnector(
+		agentCfg.CriEndpoint,
+		agentCfg.CriSocketPaths,
+		agentCfg.HostRoot,
+		agentCfg.CriTimeout,
+		logger,
+	)
+	defer criConnector.Close()
+
+	if agentCfg.NoCri {
+		logger.Info("CRI disabled, running in node-only mode")
+	} else {
+		logger.Info("discovering CRI socket")
+		if err := criConnector.Connect(ctx); err != nil {
+			logger.Warn("CRI socket discovery failed, running in node-only mode",
+				zap.Duration("retry_interval", agentCfg.CriRetryInterval),
+				zap.Error(err),
+			)
+			go criConnector.Retry(ctx, agentCfg.CriRetryInterval)
+		}
 	}
-	logger.Info("CRI socket discovered", zap.String("socket", criSocket))
-
-	runtimeClient, criConn := utils.SetupCRIConnection(criSocket, logger)
-	defer func() {
-		logger.Info("closing CRI connection")
-		_ = criConn.Close()
-	}()
 
 	relayClient, relayConn := utils.SetupRelayConnection(agentCfg.RelayAddress, logger)
 	defer func() {
@@ -79,14 +100,49 @@ func main() {
 		_ = relayConn.Close()
 	}()
 
-	bufferSize := computeBufferSize(agentCfg.MainLoopDurationSeconds, agentCfg.BufferRetention)
-	buffer := datastructure.NewRingBuffer[*gen.Metrics](bufferSize)
-	logger.Info("metrics ring buffer initialized", zap.Int("buffer_size", bufferSize))
+	metricsBuffer := buffer.NewSnapshotBuffer(
+		agentCfg.BufferMaxBytes,
+		agentCfg.BufferDropPolicy,
+		agentCfg.BufferThinFactor,
+		agentCfg.BufferRetention,
+	)
+	logger.Info("metrics buffer initialized",
+		zap.Int("buffer_max_bytes", agentCfg.BufferMaxBytes),
+		zap.String("buffer_drop_policy", string(agentCfg.BufferDropPolicy)),
+		zap.Duration("buffer_retention", agentCfg.BufferRetention),
+	)
+
+	healthState := health.NewState(agentCfg.MainLoopDurationSeconds, agentCfg.LivenessIntervals, !agentCfg.NoCri)
+	healthState.RecordBuffer(metricsBuffer.Stats())
+	if agentCfg.HealthPort > 0 {
+		go health.Serve(ctx, agentCfg.HealthPort, healthState, logger.Named("health"))
+	}
+
+	collectorState := metrics.NewCollectorState(ctx, healthState, agentCfg, logger)
+	go collectorState.Events.Run(ctx)
+	if !agentCfg.NoCri {
+		go collectorState.Lifecycle.Run(ctx, criConnector.Client)
+	}
 
 	// SCOPED loggers
 	collectorLogger := logger.Named("collector")
 	senderLogger := logger.Named("sender")
 
+	// Out-of-cycle snapshot requests from PSI triggers, coalesced while one is pending.
+	pressureSnapshots := make(chan struct{}, 1)
+	if len(agentCfg.PsiTriggers) > 0 {
+		psiMonitor := psi.NewMonitor(agentCfg.PsiTriggers, logger.Named("psi"))
+		go psiMonitor.Run(ctx, func(event *gen.NodeEvent) {
+			collectorState.Events.Add(event)
+			if agentCfg.PsiTriggerSnapshot {
+				select {
+				case pressureSnapshots <- struct{}{}:
+				default:
+				}
+			}
+		})
+	}
+
 	//go func() {
 	ticker := time.NewTicker(agentCfg.MainLoopDurationSeconds)
 	defer ticker.Stop()
@@ -96,46 +152,50 @@ func main() {
 		logger.Error("failed to send metrics", zap.Error(err))
 	}
 
+	collectAndSend := func() {
+		runtimeClient := criConnector.Client()
+		healthState.RecordCri(criConnector.Runtime())
+
+		collectStart := time.Now()
+		errors := metrics.CollectOnce(ctx, runtimeClient, metricsBuffer, collectorState, agentCfg, collectorLogger)
+		healthState.RecordCollect(time.Since(collectStart), errors)
+		healthState.RecordBuffer(metricsBuffer.Stats())
+
+		if errors != nil {
+			logger.Error("errors while collecting metrics", zap.Any("errors", errors))
+		}
+
+		stream, err = metrics.SendOnce(ctx, relayClient, stream, metricsBuffer, agentCfg, senderLogger)
+		healthState.RecordSend(stream != nil, err)
+		healthState.RecordBuffer(metricsBuffer.Stats())
+		if err != nil {
+			logger.Error("error while sending metrics", zap.Error(err))
+		}
+	}
+
+	// Out-of-cycle snapshots are limited to one per main loop interval, and skipped when the next
+	// tick is less than half an interval away, so that sustained pressure does not turn into a
+	// full collection every trigger window while the node is stalling.
+	lastTick := time.Now()
+	var lastPressureSnapshot time.Time
+
 	for {
 		select {
 		case <-ctx.Done():
 			return
-		case <-ticker.C:
-			errors := metrics.CollectOnce(ctx, runtimeClient, buffer, agentCfg, collectorLogger)
-
-			if errors != nil {
-				logger.Error("errors while collecting metrics", zap.Any("errors", errors))
-				continue
-			}
-
-			err := metrics.SendOnce(ctx, relayClient, stream, buffer, agentCfg, senderLogger)
-			if err != nil {
-				logger.Error("error while sending metrics", zap.Error(err))
+		case lastTick = <-ticker.C:
+			collectAndSend()
+		case <-pressureSnapshots:
+			now := time.Now()
+			nextTick := lastTick.Add(agentCfg.MainLoopDurationSeconds)
+			if now.Sub(lastPressureSnapshot) < agentCfg.MainLoopDurationSeconds ||
+				nextTick.Sub(now) < agentCfg.MainLoopDurationSeconds/2 {
+				logger.Debug("PSI trigger fired, out-of-cycle snapshot skipped")
 				continue
 			}
+			lastPressureSnapshot = now
+			logger.Info("PSI trigger fired, taking an out-of-cycle snapshot")
+			collectAndSend()
 		}
 	}
 }
-
-// computeBufferSize calculates the number of metric entries to retain in the ring buffer
-// based on the main loop interval and total retention duration.
-//
-// Parameters:
-//   - loopInterval: Duration between each collection loop (e.g., 5s)
-//   - retentionDuration: Total duration to retain metrics (e.g., 10m)
-//
-// Returns:
-//   - The number of entries the buffer should support to meet the retention window.
-func computeBufferSize(
-	loopInterval time.Duration,
-	retentionDuration time.Duration,
-) int {
-	if loopInterval <= 0 {
-		return 1
-	}
-	size := int(retentionDuration / loopInterval)
-	if size < 1 {
-		return 1
-	}
-	return size
-}
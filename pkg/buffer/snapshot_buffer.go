@@ -0,0 +1,293 @@
+package buffer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/proto"
+)
+
+// DropPolicy defines what the SnapshotBuffer discards when its byte budget is exceeded.
+type DropPolicy string
+
+const (
+	// DropOldest discards the oldest snapshots first.
+	DropOldest DropPolicy = "drop-oldest"
+
+	// DropNewest rejects incoming snapshots, preserving the beginning of an outage.
+	DropNewest DropPolicy = "drop-newest"
+
+	// Thin keeps only every Nth snapshot in the older half of the buffer, so that the whole
+	// outage remains visible at a progressively lower resolution.
+	Thin DropPolicy = "thin"
+
+	// StripPods removes pod and container metrics from the oldest snapshots, keeping their
+	// node metrics; whole snapshots are dropped only once every snapshot has been stripped.
+	StripPods DropPolicy = "strip-pods"
+)
+
+// ParseDropPolicy validates a drop policy name.
+//
+// Parameters:
+//   - value string: one of "drop-oldest", "drop-newest", "thin" or "strip-pods".
+//
+// Returns:
+//   - DropPolicy: the parsed policy.
+//   - error: non-nil if the value is not a known policy.
+func ParseDropPolicy(
+	value string,
+) (DropPolicy, error) {
+	switch p := DropPolicy(value); p {
+	case DropOldest, DropNewest, Thin, StripPods:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown drop policy %q (expected %s, %s, %s or %s)",
+			value, DropOldest, DropNewest, Thin, StripPods)
+	}
+}
+
+// Stats is a point-in-time copy of the buffer fill level and overflow counters.
+type Stats struct {
+	Len               int    // Number of snapshots currently buffered
+	Bytes             int    // Serialized size of the buffered snapshots in bytes
+	MaxBytes          int    // Byte budget of the buffer
+	DroppedSnapshots  uint64 // Snapshots discarded since start (budget overflow or retention expiry)
+	StrippedSnapshots uint64 // Snapshots whose pod metrics were removed to save space
+}
+
+// entry is a buffered snapshot together with its bookkeeping data.
+type entry struct {
+	metrics  *gen.Metrics
+	size     int       // Serialized size of metrics in bytes
+	addedAt  time.Time // Time the snapshot entered the buffer
+	stripped bool      // Whether pod metrics were already removed
+}
+
+// SnapshotBuffer is a thread-safe FIFO buffer of metrics snapshots bounded by a byte budget
+// rather than by a number of entries, since the size of a snapshot varies widely between nodes.
+//
+// When adding a snapshot makes the buffer exceed its budget, the configured DropPolicy decides
+// what is discarded. Snapshots older than the retention are discarded as well. Every discarded
+// snapshot is counted so that data loss is visible.
+//
+// All operations are safe for concurrent use by multiple goroutines.
+type SnapshotBuffer struct {
+	mu sync.Mutex
+
+	entries    []*entry
+	bytes      int
+	maxBytes   int
+	policy     DropPolicy
+	thinFactor int
+	retention  time.Duration
+
+	dropped  uint64
+	stripped uint64
+
+	// popped is the entry last returned by Pop, kept so that Unpop restores it unchanged.
+	popped *entry
+}
+
+// NewSnapshotBuffer creates an empty SnapshotBuffer.
+//
+// Parameters:
+//   - maxBytes int: byte budget of the buffer (serialized snapshot size).
+//   - policy DropPolicy: what to discard when the budget is exceeded.
+//   - thinFactor int: for the Thin policy, keep one snapshot out of thinFactor (minimum 2).
+//   - retention time.Duration: maximum age of a buffered snapshot, 0 to disable.
+//
+// Returns:
+//   - *SnapshotBuffer: the new buffer.
+func NewSnapshotBuffer(
+	maxBytes int,
+	policy DropPolicy,
+	thinFactor int,
+	retention time.Duration,
+) *SnapshotBuffer {
+	if thinFactor < 2 {
+		thinFactor = 2
+	}
+	return &SnapshotBuffer{
+		maxBytes:   maxBytes,
+		policy:     policy,
+		thinFactor: thinFactor,
+		retention:  retention,
+	}
+}
+
+// Add appends a snapshot to the buffer, then expires snapshots older than the retention
+// and applies the drop policy until the buffer fits its byte budget.
+//
+// The newest snapshot is always kept when it is alone in the buffer, even if it exceeds the
+// budget by itself: otherwise a node whose snapshots outgrow the budget would never send any.
+//
+// Parameters:
+//   - metrics *gen.Metrics: the snapshot to buffer.
+//
+// Returns:
+//   - bool: false if the snapshot itself was rejected (DropNewest policy), true otherwise.
+func (b *SnapshotBuffer) Add(
+	metrics *gen.Metrics,
+) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.expire()
+
+	size := proto.Size(metrics)
+	if b.policy == DropNewest && len(b.entries) > 0 && b.bytes+size > b.maxBytes {
+		b.dropped++
+		return false
+	}
+
+	b.entries = append(b.entries, &entry{metrics: metrics, size: size, addedAt: time.Now()})
+	b.bytes += size
+
+	for b.bytes > b.maxBytes && len(b.entries) > 1 {
+		switch b.policy {
+		case Thin:
+			if !b.thin() {
+				b.dropOldest()
+			}
+		case StripPods:
+			if !b.stripOldest() {
+				b.dropOldest()
+			}
+		default:
+			b.dropOldest()
+		}
+	}
+
+	return len(b.entries) > 0 && b.entries[len(b.entries)-1].metrics == metrics
+}
+
+// Pop removes and returns the oldest snapshot.
+//
+// Returns:
+//   - *gen.Metrics: the oldest snapshot, or nil if the buffer is empty.
+//   - bool: true if a snapshot was removed.
+func (b *SnapshotBuffer) Pop() (*gen.Metrics, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.entries) == 0 {
+		return nil, false
+	}
+	e := b.entries[0]
+	b.entries[0] = nil
+	b.entries = b.entries[1:]
+	b.bytes -= e.size
+	b.popped = e
+	return e.metrics, true
+}
+
+// Unpop puts the snapshot last returned by Pop back at the front of the buffer, typically after
+// a failed send. Its original entry time and stripped state are kept, so that it still expires
+// with the retention and is not stripped or counted twice. The byte budget is enforced again
+// on the next Add.
+//
+// Parameters:
+//   - metrics *gen.Metrics: the snapshot previously returned by Pop.
+func (b *SnapshotBuffer) Unpop(
+	metrics *gen.Metrics,
+) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	e := b.popped
+	if e == nil || e.metrics != metrics {
+		// Not the last popped snapshot: buffer it as a new one.
+		e = &entry{metrics: metrics, size: proto.Size(metrics), addedAt: time.Now()}
+	}
+	b.popped = nil
+
+	b.entries = append([]*entry{e}, b.entries...)
+	b.bytes += e.size
+}
+
+// Len returns the number of snapshots currently buffered.
+func (b *SnapshotBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.entries)
+}
+
+// Stats returns the current fill level and overflow counters of the buffer.
+func (b *SnapshotBuffer) Stats() Stats {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return Stats{
+		Len:               len(b.entries),
+		Bytes:             b.bytes,
+		MaxBytes:          b.maxBytes,
+		DroppedSnapshots:  b.dropped,
+		StrippedSnapshots: b.stripped,
+	}
+}
+
+// expire drops the snapshots older than the retention. The caller must hold b.mu.
+func (b *SnapshotBuffer) expire() {
+	if b.retention <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-b.retention)
+	for len(b.entries) > 0 && b.entries[0].addedAt.Before(cutoff) {
+		b.dropOldest()
+	}
+}
+
+// dropOldest removes the oldest snapshot and counts it as dropped. The caller must hold b.mu.
+func (b *SnapshotBuffer) dropOldest() {
+	b.bytes -= b.entries[0].size
+	b.entries[0] = nil
+	b.entries = b.entries[1:]
+	b.dropped++
+}
+
+// thin keeps one snapshot out of thinFactor in the older half of the buffer.
+// The caller must hold b.mu.
+//
+// Returns:
+//   - bool: false if the older half is too small to be thinned.
+func (b *SnapshotBuffer) thin() bool {
+	half := len(b.entries) / 2
+	if half < 2 {
+		return false
+	}
+
+	kept := make([]*entry, 0, len(b.entries))
+	for i, e := range b.entries {
+		if i >= half || i%b.thinFactor == 0 {
+			kept = append(kept, e)
+			continue
+		}
+		b.bytes -= e.size
+		b.dropped++
+	}
+	b.entries = kept
+	return true
+}
+
+// stripOldest removes pod metrics from the oldest snapshot that still has them.
+// The caller must hold b.mu.
+//
+// Returns:
+//   - bool: false if every buffered snapshot is already stripped.
+func (b *SnapshotBuffer) stripOldest() bool {
+	for _, e := range b.entries {
+		if e.stripped {
+			continue
+		}
+		e.metrics.PodMetrics = nil
+		e.stripped = true
+		b.bytes -= e.size
+		e.size = proto.Size(e.metrics)
+		b.bytes += e.size
+		b.stripped++
+		return true
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+// Package cgroup resolves processes and cgroup paths to the Kubernetes pods and containers owning them.
+package cgroup
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+)
+
+var (
+	// podUIDPattern matches the pod segment of a kubelet cgroup path, with either the cgroupfs
+	// driver ("pod<uid>") or the systemd driver ("kubepods-burstable-pod<uid with underscores>.slice").
+	podUIDPattern = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})`)
+
+	// containerIDPattern matches a 64 hex digit container ID, either alone ("<id>") or wrapped in a
+	// systemd scope ("cri-containerd-<id>.scope", "crio-<id>.scope", "docker-<id>.scope").
+	containerIDPattern = regexp.MustCompile(`(?:^|[-/])([0-9a-f]{64})(?:\.scope)?$`)
+)
+
+// Ref identifies the pod and container owning a cgroup.
+type Ref struct {
+	PodUID      string // UID of the pod (matches metadata.uid), empty if the cgroup is not under kubepods
+	ContainerID string // ID of the container (matches the CRI container ID), empty for pod-level cgroups
+}
+
+// ParseRef extracts the pod UID and container ID from a cgroup path.
+//
+// Both the cgroupfs driver layout (/kubepods/burstable/pod<uid>/<id>) and the systemd driver
+// layout (/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod<uid>.slice/cri-containerd-<id>.scope)
+// are supported. The underscores used by the systemd driver in pod UIDs are turned back into dashes.
+//
+// Parameters:
+//   - path string: the cgroup path (e.g., as read from /proc/<pid>/cgroup).
+//
+// Returns:
+//   - Ref: the pod UID and container ID, empty when the path does not belong to a pod.
+func ParseRef(
+	path string,
+) Ref {
+	var ref Ref
+
+	match := podUIDPattern.FindStringSubmatch(path)
+	if match == nil {
+		return ref
+	}
+	ref.PodUID = strings.ReplaceAll(match[1], "_", "-")
+
+	if match := containerIDPattern.FindStringSubmatch(path); match != nil {
+		ref.ContainerID = match[1]
+	}
+
+	return ref
+}
+
+// ProcessCgroup reads the cgroup path of a process from /proc/<pid>/cgroup.
+//
+// On cgroup v2 the unified hierarchy entry ("0::<path>") is returned. On cgroup v1 and on hybrid
+// hosts, where the unified entry is usually just "/", the entry of the memory controller is
+// preferred, then the cpu controller, then the unified entry, then the first entry.
+//
+// Parameters:
+//   - pid int32: the process ID, as seen from the host /proc.
+//
+// Returns:
+//   - string: the cgroup path (e.g., "/kubepods/burstable/pod<uid>/<id>").
+//   - error: non-nil if the file cannot be read.
+func ProcessCgroup(
+	pid int32,
+) (string, error) {
+	file, err := os.Open(utils.HostProc(strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = file.Close() }()
+
+	var first, cpu, memory, unified string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		if fields[0] == "0" && fields[1] == "" {
+			unified = fields[2]
+			continue
+		}
+		if first == "" {
+			first = fields[2]
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			switch controller {
+			case "memory":
+				memory = fields[2]
+			case "cpu":
+				cpu = fields[2]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	switch {
+	case memory != "":
+		return memory, nil
+	case cpu != "":
+		return cpu, nil
+	case unified != "":
+		return unified, nil
+	default:
+		return first, nil
+	}
+}
+
+// IsV2 reports whether the host uses the cgroup v2 unified hierarchy at /sys/fs/cgroup.
+//
+// Returns:
+//   - bool: true on cgroup v2 hosts, false on cgroup v1 and hybrid hosts.
+func IsV2() bool {
+	_, err := os.Stat(utils.HostSys("fs", "cgroup", "cgroup.controllers"))
+	return err == nil
+}
+
+// ControllerPath builds the path of a cgroup directory for a controller.
+//
+// On cgroup v2 all controllers share the unified hierarchy and the controller is ignored.
+// On cgroup v1 the path is looked up under the hierarchy of the controller (e.g., /sys/fs/cgroup/pids).
+//
+// Parameters:
+//   - controller string: the cgroup v1 controller (e.g., "pids", "memory", "cpu").
+//   - path string: the cgroup path relative to the hierarchy root (e.g., "/kubepods.slice").
+//
+// Returns:
+//   - string: the absolute path of the cgroup directory under the host /sys.
+func ControllerPath(
+	controller string,
+	path string,
+) string {
+	if IsV2() {
+		return utils.HostSys("fs", "cgroup", path)
+	}
+	return utils.HostSys("fs", "cgroup", controller, path)
+}
+
+// KubepodsPath finds the kubelet root cgroup of the pods ("/kubepods.slice" with the systemd
+// driver, "/kubepods" with the cgroupfs driver) in the hierarchy of a controller.
+//
+// Parameters:
+//   - controller string: the cgroup v1 controller (ignored on cgroup v2).
+//
+// Returns:
+//   - string: the absolute path of the kubepods cgroup directory.
+//   - bool: false if no kubepods cgroup exists (e.g., not a Kubernetes node).
+func KubepodsPath(
+	controller string,
+) (string, bool) {
+	for _, path := range []string{"kubepods.slice", "kubepods"} {
+		dir := ControllerPath(controller, path)
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir, true
+		}
+	}
+	return "", false
+}
+
+// Kubepods maps the kubelet cgroups of the node to their paths, relative to the hierarchy root
+// (e.g., "/kubepods.slice/kubepods-burstable.slice"); see ControllerPath to build their directories.
+type Kubepods struct {
+	QoS        map[string]string // QoS tier ("kubepods", "burstable", "besteffort") to cgroup path
+	Pods       map[string]string // Pod UID to cgroup path
+	Containers map[string]string // Container ID to cgroup path
+}
+
+// ScanKubepods walks the kubepods cgroup of a controller and finds the cgroups of the QoS
+// tiers, of the pods and of their containers.
+//
+// Both the cgroupfs driver layout (kubepods/burstable/pod<uid>/<id>) and the systemd driver
+// layout (kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod<uid>.slice/cri-containerd-<id>.scope)
+// are supported. Guaranteed pods live directly under the kubepods cgroup. On cgroup v1, kubelet
+// creates the same paths in every controller hierarchy, so the paths found apply to all of them.
+//
+// Parameters:
+//   - controller string: the cgroup v1 controller whose hierarchy is walked (ignored on cgroup v2).
+//
+// Returns:
+//   - *Kubepods: the cgroups found, nil if the node has no kubepods cgroup (e.g., not a Kubernetes node).
+//   - error: non-nil if the kubepods cgroup cannot be walked.
+func ScanKubepods(
+	controller string,
+) (*Kubepods, error) {
+	root, ok := KubepodsPath(controller)
+	if !ok {
+		return nil, nil
+	}
+	hierarchy := ControllerPath(controller, "/")
+
+	relative := func(dir string) string {
+		rel, _ := filepath.Rel(hierarchy, dir)
+		return "/" + rel
+	}
+
+	kubepods := &Kubepods{
+		QoS:        map[string]string{"kubepods": relative(root)},
+		Pods:       make(map[string]string),
+		Containers: make(map[string]string),
+	}
+
+	for _, tier := range []string{"burstable", "besteffort"} {
+		for _, name := range []string{tier, "kubepods-" + tier + ".slice"} {
+			dir := filepath.Join(root, name)
+			if info, err := os.Stat(dir); err == nil && info.IsDir() {
+				kubepods.QoS[tier] = relative(dir)
+				break
+			}
+		}
+	}
+
+	err := filepath.WalkDir(root, func(dir string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			// Cgroups removed during the walk are skipped.
+			if dir != root && errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !entry.IsDir() || dir == root {
+			return nil
+		}
+
+		name := entry.Name()
+		if strings.Contains(name, "conmon") {
+			// CRI-O monitor process of a container ("crio-conmon-<id>.scope"), not the container itself.
+			return filepath.SkipDir
+		}
+		if match := containerIDPattern.FindStringSubmatch(name); match != nil {
+			kubepods.Containers[match[1]] = relative(dir)
+			return filepath.SkipDir
+		}
+		if match := podUIDPattern.FindStringSubmatch(name); match != nil {
+			kubepods.Pods[strings.ReplaceAll(match[1], "_", "-")] = relative(dir)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return kubepods, nil
+}
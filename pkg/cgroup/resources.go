@@ -0,0 +1,113 @@
+package cgroup
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ReadResources reads the resources applied to a cgroup (CPU shares, quota and period,
+// memory limit and cpuset), on cgroup v1 or v2.
+//
+// On cgroup v2, cpu.weight is converted back to CPU shares with the inverse of the conversion
+// used by the container runtimes, so that both versions report shares. Only the raw values
+// are set: the source and the derived values in cores are left to the caller.
+//
+// Parameters:
+//   - path string: the cgroup path relative to the hierarchy root (e.g., as found by ScanKubepods).
+//
+// Returns:
+//   - *gen.ContainerResources: the resources.
+//   - error: non-nil if the CPU controller files cannot be read (e.g., the cgroup was removed).
+func ReadResources(
+	path string,
+) (*gen.ContainerResources, error) {
+	resources := &gen.ContainerResources{}
+
+	if IsV2() {
+		dir := ControllerPath("", path)
+
+		weight, err := utils.ReadUint64File(filepath.Join(dir, "cpu.weight"))
+		if err != nil {
+			return nil, err
+		}
+		resources.CpuShares = weightToShares(weight)
+
+		// "<quota|max> <period>"
+		if data, err := os.ReadFile(filepath.Join(dir, "cpu.max")); err == nil {
+			fields := strings.Fields(string(data))
+			if len(fields) == 2 {
+				if quota, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+					resources.CpuQuotaUsec = wrapperspb.UInt64(quota)
+				}
+				resources.CpuPeriodUsec, _ = strconv.ParseUint(fields[1], 10, 64)
+			}
+		}
+
+		// "max" when unlimited.
+		if limit, err := utils.ReadUint64File(filepath.Join(dir, "memory.max")); err == nil {
+			resources.MemoryLimitBytes = wrapperspb.UInt64(limit)
+		}
+
+		resources.CpusetCpus = readTrimmed(filepath.Join(dir, "cpuset.cpus"))
+		resources.CpusetMems = readTrimmed(filepath.Join(dir, "cpuset.mems"))
+
+		return resources, nil
+	}
+
+	cpuDir := ControllerPath("cpu", path)
+
+	shares, err := utils.ReadUint64File(filepath.Join(cpuDir, "cpu.shares"))
+	if err != nil {
+		return nil, err
+	}
+	resources.CpuShares = shares
+
+	// cpu.cfs_quota_us is -1 when unlimited.
+	if data, err := os.ReadFile(filepath.Join(cpuDir, "cpu.cfs_quota_us")); err == nil {
+		if quota, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err == nil && quota > 0 {
+			resources.CpuQuotaUsec = wrapperspb.UInt64(uint64(quota))
+		}
+	}
+	resources.CpuPeriodUsec, _ = utils.ReadUint64File(filepath.Join(cpuDir, "cpu.cfs_period_us"))
+
+	memoryDir := ControllerPath("memory", path)
+	if limit, err := utils.ReadUint64File(filepath.Join(memoryDir, "memory.limit_in_bytes")); err == nil && limit < unlimitedV1 {
+		resources.MemoryLimitBytes = wrapperspb.UInt64(limit)
+	}
+
+	cpusetDir := ControllerPath("cpuset", path)
+	resources.CpusetCpus = readTrimmed(filepath.Join(cpusetDir, "cpuset.cpus"))
+	resources.CpusetMems = readTrimmed(filepath.Join(cpusetDir, "cpuset.mems"))
+
+	return resources, nil
+}
+
+// weightToShares converts a cgroup v2 cpu.weight (1-10000) back to cgroup v1 CPU shares (2-262144),
+// inverting the conversion of the OCI runtimes: weight = 1 + ((shares - 2) * 9999) / 262142.
+// The conversion is lossy, so the middle of the range of shares mapping to the weight is returned
+// (e.g., 1011 for the weight 39 of a 1 core request).
+func weightToShares(
+	weight uint64,
+) uint64 {
+	if weight == 0 {
+		return 0
+	}
+	return 2 + ((2*(weight-1)+1)*262142)/(2*9999)
+}
+
+// readTrimmed reads a single-line file, returning an empty string if it cannot be read.
+func readTrimmed(
+	path string,
+) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
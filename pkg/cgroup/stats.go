@@ -0,0 +1,375 @@
+package cgroup
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// unlimitedV1 is the threshold above which a cgroup v1 byte limit means "no limit": the kernel
+	// reports its maximum page counter (e.g., 9223372036854771712) rather than a keyword.
+	unlimitedV1 = uint64(1) << 62
+
+	// userHz is the unit of cpuacct.stat (USER_HZ, 100 on every supported architecture).
+	userHz = 100
+)
+
+// ReadStats reads the statistics of a cgroup from the cgroup filesystem, on cgroup v1 or v2.
+//
+// Statistics whose files are missing (e.g., a controller not enabled for the cgroup, or a
+// kernel too old to expose a counter) are left zero or unset; only a cgroup that cannot be
+// read at all is an error.
+//
+// Parameters:
+//   - path string: the cgroup path relative to the hierarchy root (e.g., as found by ScanKubepods).
+//
+// Returns:
+//   - *gen.CgroupMetrics: the statistics.
+//   - error: non-nil if neither the CPU nor the memory statistics can be read (e.g., the cgroup was removed).
+func ReadStats(
+	path string,
+) (*gen.CgroupMetrics, error) {
+	metrics := &gen.CgroupMetrics{
+		Timestamp: time.Now().UnixNano(),
+		Path:      path,
+	}
+
+	var cpuErr, memoryErr error
+	if IsV2() {
+		dir := ControllerPath("", path)
+		metrics.Version = 2
+		metrics.Cpu, cpuErr = readCpuV2(dir)
+		metrics.Memory, memoryErr = readMemoryV2(dir)
+		metrics.Io = readIoV2(dir)
+		metrics.Pids = readPids(dir)
+		metrics.HugetlbUsageBytes = readHugetlb(dir, "current")
+	} else {
+		metrics.Version = 1
+		metrics.Cpu, cpuErr = readCpuV1(path)
+		metrics.Memory, memoryErr = readMemoryV1(ControllerPath("memory", path))
+		metrics.Io = readIoV1(ControllerPath("blkio", path))
+		metrics.Pids = readPids(ControllerPath("pids", path))
+		metrics.HugetlbUsageBytes = readHugetlb(ControllerPath("hugetlb", path), "usage_in_bytes")
+	}
+
+	if cpuErr != nil && memoryErr != nil {
+		return nil, cpuErr
+	}
+
+	return metrics, nil
+}
+
+// readCpuV2 reads cpu.stat and cpu.max of a cgroup v2 directory.
+func readCpuV2(
+	dir string,
+) (*gen.CgroupCpuStats, error) {
+	stat, err := readKeyValues(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return nil, err
+	}
+
+	cpu := &gen.CgroupCpuStats{
+		UsageUsec:        stat["usage_usec"],
+		UserUsec:         stat["user_usec"],
+		SystemUsec:       stat["system_usec"],
+		Periods:          stat["nr_periods"],
+		ThrottledPeriods: stat["nr_throttled"],
+		ThrottledUsec:    stat["throttled_usec"],
+	}
+
+	// "<quota|max> <period>"
+	if data, err := os.ReadFile(filepath.Join(dir, "cpu.max")); err == nil {
+		fields := strings.Fields(string(data))
+		if len(fields) == 2 {
+			if quota, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+				cpu.QuotaUsec = wrapperspb.UInt64(quota)
+			}
+			cpu.PeriodUsec, _ = strconv.ParseUint(fields[1], 10, 64)
+		}
+	}
+
+	return cpu, nil
+}
+
+// readCpuV1 reads the cpuacct and cpu controller files of a cgroup v1 path.
+func readCpuV1(
+	path string,
+) (*gen.CgroupCpuStats, error) {
+	cpuacct := ControllerPath("cpuacct", path)
+	cpuDir := ControllerPath("cpu", path)
+
+	usage, err := utils.ReadUint64File(filepath.Join(cpuacct, "cpuacct.usage"))
+	if err != nil {
+		return nil, err
+	}
+
+	cpu := &gen.CgroupCpuStats{UsageUsec: usage / 1000}
+
+	if stat, err := readKeyValues(filepath.Join(cpuacct, "cpuacct.stat")); err == nil {
+		cpu.UserUsec = stat["user"] * (1e6 / userHz)
+		cpu.SystemUsec = stat["system"] * (1e6 / userHz)
+	}
+
+	if stat, err := readKeyValues(filepath.Join(cpuDir, "cpu.stat")); err == nil {
+		cpu.Periods = stat["nr_periods"]
+		cpu.ThrottledPeriods = stat["nr_throttled"]
+		cpu.ThrottledUsec = stat["throttled_time"] / 1000
+	}
+
+	// cpu.cfs_quota_us is -1 when unlimited.
+	if data, err := os.ReadFile(filepath.Join(cpuDir, "cpu.cfs_quota_us")); err == nil {
+		if quota, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err == nil && quota > 0 {
+			cpu.QuotaUsec = wrapperspb.UInt64(uint64(quota))
+		}
+	}
+	cpu.PeriodUsec, _ = utils.ReadUint64File(filepath.Join(cpuDir, "cpu.cfs_period_us"))
+
+	return cpu, nil
+}
+
+// readMemoryV2 reads memory.current, memory.max, memory.stat and memory.events of a cgroup v2 directory.
+func readMemoryV2(
+	dir string,
+) (*gen.CgroupMemoryStats, error) {
+	usage, err := utils.ReadUint64File(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return nil, err
+	}
+
+	memory := &gen.CgroupMemoryStats{UsageBytes: usage}
+
+	// "max" when unlimited.
+	if limit, err := utils.ReadUint64File(filepath.Join(dir, "memory.max")); err == nil {
+		memory.LimitBytes = wrapperspb.UInt64(limit)
+	}
+
+	if stat, err := readKeyValues(filepath.Join(dir, "memory.stat")); err == nil {
+		memory.AnonBytes = stat["anon"]
+		memory.FileBytes = stat["file"]
+		memory.ShmemBytes = stat["shmem"]
+		memory.SlabBytes = stat["slab"]
+		memory.SockBytes = stat["sock"]
+		memory.KernelBytes = stat["kernel"]
+		if _, ok := stat["kernel"]; !ok {
+			// Linux < 5.18 has no aggregate: sum its main components.
+			memory.KernelBytes = stat["kernel_stack"] + stat["pagetables"] + stat["slab"]
+		}
+	}
+
+	if events, err := readKeyValues(filepath.Join(dir, "memory.events")); err == nil {
+		memory.HighEvents = events["high"]
+		memory.MaxEvents = events["max"]
+		memory.OomEvents = events["oom"]
+		memory.OomKillEvents = events["oom_kill"]
+	}
+
+	return memory, nil
+}
+
+// readMemoryV1 reads the memory controller files of a cgroup v1 directory.
+func readMemoryV1(
+	dir string,
+) (*gen.CgroupMemoryStats, error) {
+	usage, err := utils.ReadUint64File(filepath.Join(dir, "memory.usage_in_bytes"))
+	if err != nil {
+		return nil, err
+	}
+
+	memory := &gen.CgroupMemoryStats{UsageBytes: usage}
+
+	if limit, err := utils.ReadUint64File(filepath.Join(dir, "memory.limit_in_bytes")); err == nil && limit < unlimitedV1 {
+		memory.LimitBytes = wrapperspb.UInt64(limit)
+	}
+
+	if stat, err := readKeyValues(filepath.Join(dir, "memory.stat")); err == nil {
+		memory.AnonBytes = stat["rss"]
+		memory.FileBytes = stat["cache"]
+		memory.ShmemBytes = stat["shmem"]
+	}
+
+	memory.KernelBytes, _ = utils.ReadUint64File(filepath.Join(dir, "memory.kmem.usage_in_bytes"))
+	memory.SockBytes, _ = utils.ReadUint64File(filepath.Join(dir, "memory.kmem.tcp.usage_in_bytes"))
+	memory.MaxEvents, _ = utils.ReadUint64File(filepath.Join(dir, "memory.failcnt"))
+
+	// oom_kill is reported since Linux 4.13.
+	if oomControl, err := readKeyValues(filepath.Join(dir, "memory.oom_control")); err == nil {
+		memory.OomKillEvents = oomControl["oom_kill"]
+	}
+
+	return memory, nil
+}
+
+// readIoV2 sums the io.stat counters of a cgroup v2 directory over all devices.
+// Each line reads "<major>:<minor> rbytes=... wbytes=... rios=... wios=... dbytes=... dios=...".
+func readIoV2(
+	dir string,
+) *gen.CgroupIoStats {
+	file, err := os.Open(filepath.Join(dir, "io.stat"))
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = file.Close() }()
+
+	io := &gen.CgroupIoStats{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		for _, field := range fields[1:] {
+			key, raw, ok := strings.Cut(field, "=")
+			if !ok {
+				continue
+			}
+			value, err := strconv.ParseUint(raw, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch key {
+			case "rbytes":
+				io.ReadBytes += value
+			case "wbytes":
+				io.WriteBytes += value
+			case "rios":
+				io.ReadOps += value
+			case "wios":
+				io.WriteOps += value
+			}
+		}
+	}
+
+	return io
+}
+
+// readIoV1 reads blkio.throttle.io_service_bytes and blkio.throttle.io_serviced of a cgroup v1 directory.
+func readIoV1(
+	dir string,
+) *gen.CgroupIoStats {
+	readBytes, writeBytes, err := readBlkioFile(filepath.Join(dir, "blkio.throttle.io_service_bytes"))
+	if err != nil {
+		return nil
+	}
+	readOps, writeOps, _ := readBlkioFile(filepath.Join(dir, "blkio.throttle.io_serviced"))
+
+	return &gen.CgroupIoStats{
+		ReadBytes:  readBytes,
+		WriteBytes: writeBytes,
+		ReadOps:    readOps,
+		WriteOps:   writeOps,
+	}
+}
+
+// readBlkioFile sums the Read and Write lines ("<major>:<minor> Read <value>") of a cgroup v1 blkio file over all devices.
+func readBlkioFile(
+	path string,
+) (uint64, uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var read, write uint64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 {
+			// Skips the trailing "Total <value>" line.
+			continue
+		}
+		value, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		switch fields[1] {
+		case "Read":
+			read += value
+		case "Write":
+			write += value
+		}
+	}
+
+	return read, write, scanner.Err()
+}
+
+// readPids reads pids.current and pids.max ("max" when unlimited) of a cgroup directory.
+func readPids(
+	dir string,
+) *gen.CgroupPidsStats {
+	current, err := utils.ReadUint64File(filepath.Join(dir, "pids.current"))
+	if err != nil {
+		return nil
+	}
+
+	pids := &gen.CgroupPidsStats{Current: current}
+	if limit, err := utils.ReadUint64File(filepath.Join(dir, "pids.max")); err == nil {
+		pids.Max = wrapperspb.UInt64(limit)
+	}
+
+	return pids
+}
+
+// readHugetlb reads the huge pages usage of a cgroup directory for every page size, from the
+// hugetlb.<size>.<suffix> files ("current" on cgroup v2, "usage_in_bytes" on cgroup v1).
+func readHugetlb(
+	dir string,
+	suffix string,
+) map[string]uint64 {
+	paths, _ := filepath.Glob(filepath.Join(dir, "hugetlb.*."+suffix))
+
+	var usage map[string]uint64
+	for _, path := range paths {
+		// Skips hugetlb.<size>.rsvd.<suffix> (reservations).
+		parts := strings.Split(filepath.Base(path), ".")
+		if len(parts) != 3 {
+			continue
+		}
+		value, err := utils.ReadUint64File(path)
+		if err != nil {
+			continue
+		}
+		if usage == nil {
+			usage = make(map[string]uint64)
+		}
+		usage[parts[1]] = value
+	}
+
+	return usage
+}
+
+// readKeyValues parses a flat keyed cgroup file ("<key> <value>" per line, e.g., cpu.stat or memory.stat).
+func readKeyValues(
+	path string,
+) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	values := make(map[string]uint64)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		if value, err := strconv.ParseUint(fields[1], 10, 64); err == nil {
+			values[fields[0]] = value
+		}
+	}
+
+	return values, scanner.Err()
+}
@@ -4,19 +4,69 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/kubensage/kubensage-agent/pkg/buffer"
 	"github.com/kubensage/kubensage-agent/pkg/buildinfo"
+	"github.com/kubensage/kubensage-agent/pkg/labels"
+	"github.com/kubensage/kubensage-agent/pkg/psi"
 	"go.uber.org/zap"
 )
 
+const (
+	// defaultFsIncludeTypes lists the filesystem types reported in DiskUsage by default: persistent
+	// disk and network filesystems, plus overlay, FUSE and tmpfs which may back the kubelet or runtime roots.
+	defaultFsIncludeTypes = "ext2,ext3,ext4,xfs,btrfs,zfs,f2fs,nilfs2,ntfs,ntfs3,exfat,vfat,fat,refs,apfs,hfs,ufs," +
+		"nfs,nfs4,cifs,smb3,glusterfs,ceph,lustre,ocfs2,gfs2,overlay,fuse.*,tmpfs"
+
+	// defaultFsExcludeMountpoints lists the mountpoints left out of DiskUsage by default: pseudo filesystems,
+	// runtime state under /run, and the per-pod and per-container mounts created by kubelet and the runtimes.
+	defaultFsExcludeMountpoints = "/proc/**,/sys/**,/dev/**,/run/**,/var/run/**,/var/lib/kubelet/pods/**," +
+		"/var/lib/docker/overlay2/**,/var/lib/containers/storage/overlay/**,/var/lib/containerd/io.containerd.*/**"
+
+	// defaultAnnotationExclude lists the annotations left out by default: the full object applied by kubectl,
+	// which is large and may embed sensitive configuration.
+	defaultAnnotationExclude = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// Pod sandbox states collected, as set by --pod-state-filter.
+const (
+	PodStateReady = "ready" // Only SANDBOX_READY pod sandboxes
+	PodStateAll   = "all"   // Every pod sandbox, including SANDBOX_NOTREADY ones
+)
+
 // AgentConfig holds runtime configuration parameters for the agent,
 // parsed from command-line flags.
 type AgentConfig struct {
-	RelayAddress            string        // Address of the relay gRPC server
-	MainLoopDurationSeconds time.Duration // Duration of the main collection loop
-	BufferRetention         time.Duration // Retention time for buffered metrics
-	TopN                    int           // Number of top memory-consuming processes to track
+	RelayAddress            string            // Address of the relay gRPC server
+	MainLoopDurationSeconds time.Duration     // Duration of the main collection loop
+	BufferRetention         time.Duration     // Retention time for buffered metrics
+	BufferMaxBytes          int               // Byte budget of the metrics buffer
+	BufferDropPolicy        buffer.DropPolicy // What the buffer discards when over budget
+	BufferThinFactor        int               // Keep one out of N old snapshots with the "thin" policy
+	TopN                    int               // Number of top processes reported by CPU and by memory
+	CriEndpoint             string            // Explicit CRI endpoint, bypasses socket discovery when set
+	CriSocketPaths          []string          // Additional CRI socket paths tried before the built-in ones
+	HostRoot                string            // Prefix of the host filesystem when running in a container
+	CriTimeout              time.Duration     // Timeout of the CRI Version RPC used to verify an endpoint
+	CriRetryInterval        time.Duration     // Interval between CRI discovery attempts in node-only mode
+	NoCri                   bool              // Disables CRI entirely and collects node metrics only
+	HealthPort              int               // Port of the health HTTP server, 0 disables it
+	LivenessIntervals       int               // Loop intervals without a finished collection before liveness fails
+	NetExcludePrefixes      []string          // Interface name prefixes whose counters are left out of the per-interface breakdown
+	KubeletRootDir          string            // Kubelet root directory, its filesystem is always reported
+	RuntimeRootDirs         []string          // Container runtime root directories, their filesystems are always reported
+	FsIncludeTypes          []string          // Filesystem type globs reported in DiskUsage (empty means all)
+	FsExcludeTypes          []string          // Filesystem type globs left out of DiskUsage
+	FsIncludeMountpoints    []string          // Mountpoint globs reported in DiskUsage (empty means all)
+	FsExcludeMountpoints    []string          // Mountpoint globs left out of DiskUsage
+	PsiTriggers             []psi.Trigger     // PSI triggers registered on the node pressure files
+	PsiTriggerSnapshot      bool              // Takes an out-of-cycle snapshot when a PSI trigger fires
+	LabelFilter             labels.Filter     // Pod and container labels reported, with their maximum value length
+	AnnotationFilter        labels.Filter     // Pod and container annotations reported, with their maximum value length
+	PodStateFilter          string            // Pod sandbox states collected: PodStateReady or PodStateAll
+	ContainerEventsPoll     time.Duration     // Container list polling interval while the CRI event stream is down, 0 disables it
 }
 
 // RegisterAgentFlags registers the CLI flags required to configure the kubensage agent.
@@ -38,10 +88,108 @@ type AgentConfig struct {
 //	  Duration of the main collection loop in seconds (default: 5)
 //
 //	--buffer-retention int
-//	  Total retention time in minutes for buffered metrics (default: 10)
+//	  Maximum age in minutes of a buffered snapshot (default: 10)
+//
+//	--buffer-max-mb int
+//	  Byte budget of the metrics buffer in MiB, must be > 0 (default: 64)
+//
+//	--buffer-drop-policy string
+//	  What to discard when the buffer is over budget: "drop-oldest", "drop-newest", "thin" or "strip-pods"
+//	  (default: "drop-oldest")
+//
+//	--buffer-thin-factor int
+//	  With the "thin" policy, keep one out of N snapshots in the older half of the buffer (default: 2)
 //
 //	--top-n int
-//	  Number of top memory-consuming processes to report (default: 10)
+//	  Number of top processes to report by CPU and by memory, must be >= 0 (default: 10)
+//
+//	--cri-endpoint string
+//	  CRI endpoint to use instead of discovery (e.g. "unix:///run/containerd/containerd.sock")
+//
+//	--cri-socket-paths string
+//	  Comma-separated CRI socket paths tried before the built-in candidates
+//
+//	--host-root string
+//	  Prefix of the host filesystem, used when the agent runs in a container (e.g. "/host"); the host /proc
+//	  and /sys are read under it unless HOST_PROC and HOST_SYS are set
+//
+//	--cri-timeout int
+//	  Timeout in seconds of the CRI Version RPC used to verify an endpoint, must be > 0 (default: 2)
+//
+//	--cri-retry-interval int
+//	  Interval in seconds between CRI discovery attempts while running in node-only mode, must be > 0 (default: 30)
+//
+//	--no-cri
+//	  If set, never connects to a container runtime and collects node metrics only (e.g. bare-metal hosts)
+//
+//	--health-port int
+//	  Port serving /healthz, /readyz and /statusz, 0 disables the health server (default: 8090)
+//
+//	--liveness-intervals int
+//	  Number of main loop intervals without a finished collection before /healthz fails, must be >= 1 (default: 3)
+//
+//	--net-exclude-prefixes string
+//	  Comma-separated interface name prefixes whose counters are left out of the per-interface network
+//	  breakdown; the node network aggregate still sums all interfaces (default: "lo,veth,cali")
+//
+//	--kubelet-root-dir string
+//	  Kubelet root directory, whose filesystem is always reported and flagged (default: "/var/lib/kubelet")
+//
+//	--runtime-root-dirs string
+//	  Comma-separated container runtime root directories, whose filesystems are always reported and flagged
+//	  (default: "/var/lib/containerd,/var/lib/containers/storage,/var/lib/docker")
+//
+//	--fs-include-types string
+//	  Comma-separated filesystem type globs reported in DiskUsage, empty for all
+//	  (default: common disk and network filesystems, "overlay", "fuse.*" and "tmpfs")
+//
+//	--fs-exclude-types string
+//	  Comma-separated filesystem type globs left out of DiskUsage (default: "")
+//
+//	--fs-include-mountpoints string
+//	  Comma-separated mountpoint globs reported in DiskUsage, empty for all (default: "")
+//
+//	--fs-exclude-mountpoints string
+//	  Comma-separated mountpoint globs left out of DiskUsage; a glob ending in "/**" matches a directory and
+//	  everything below it (default: pseudo filesystems, /run and per-pod or per-container mounts)
+//
+//	--psi-trigger-cpu string
+//	--psi-trigger-memory string
+//	--psi-trigger-io string
+//	  PSI trigger registered on /proc/pressure/<resource>, in the kernel format "<some|full> <stall us> <window us>",
+//	  empty to disable; a fired trigger is reported as a "psi_trigger" NodeEvent. The window must be between
+//	  500ms and 10s, and a multiple of 2s when the agent lacks CAP_SYS_RESOURCE (Linux 6.5+)
+//	  (default: "some 150000 1000000" for memory, disabled for cpu and io)
+//
+//	--psi-trigger-snapshot
+//	  If set, a fired PSI trigger also takes an out-of-cycle snapshot instead of waiting for the next tick,
+//	  at most once per main loop interval and not when the next tick is less than half an interval away
+//
+//	--label-include string
+//	  Comma-separated key globs of the pod and container labels reported, empty for all; "*" also matches "/"
+//	  (default: "")
+//
+//	--label-exclude string
+//	  Comma-separated key globs of the pod and container labels left out (default: "")
+//
+//	--annotation-include string
+//	  Comma-separated key globs of the pod and container annotations reported, empty for all (default: "")
+//
+//	--annotation-exclude string
+//	  Comma-separated key globs of the pod and container annotations left out
+//	  (default: "kubectl.kubernetes.io/last-applied-configuration")
+//
+//	--label-max-value-length int
+//	  Maximum length in bytes of a reported label or annotation value, longer values are truncated,
+//	  0 for unlimited (default: 256)
+//
+//	--pod-state-filter string
+//	  Pod sandboxes collected: "ready" for SANDBOX_READY ones only, or "all" to also report stopped sandboxes
+//	  and their exited containers (default: "ready")
+//
+//	--container-events-poll-interval int
+//	  Interval in seconds of the container list polling that detects lifecycle events while the CRI
+//	  GetContainerEvents stream is unavailable or not implemented by the runtime, 0 disables it (default: 1)
 //
 //	--version
 //	  If set, prints the current agent version (as defined in pkg/buildinfo.Version) and exits.
@@ -61,7 +209,36 @@ func RegisterAgentFlags(
 	relayAddress := fs.String("relay-address", "", "Relay address (required)")
 	mainLoopDuration := fs.Int("main-loop-duration", 5, "Main loop duration in seconds")
 	bufferRetention := fs.Int("buffer-retention", 10, "Buffer retention in minutes")
+	bufferMaxMB := fs.Int("buffer-max-mb", 64, "Buffer byte budget in MiB")
+	bufferDropPolicy := fs.String("buffer-drop-policy", string(buffer.DropOldest), "Buffer drop policy: drop-oldest, drop-newest, thin or strip-pods")
+	bufferThinFactor := fs.Int("buffer-thin-factor", 2, "Keep one out of N old snapshots with the thin drop policy")
 	topN := fs.Int("top-n", 10, "Top N processes")
+	criEndpoint := fs.String("cri-endpoint", "", "CRI endpoint, disables socket discovery when set")
+	criSocketPaths := fs.String("cri-socket-paths", "", "Comma-separated CRI socket paths tried before the built-in ones")
+	hostRoot := fs.String("host-root", "", "Host filesystem prefix when running in a container")
+	criTimeout := fs.Int("cri-timeout", 2, "CRI endpoint verification timeout in seconds")
+	criRetryInterval := fs.Int("cri-retry-interval", 30, "CRI discovery retry interval in seconds while in node-only mode")
+	noCri := fs.Bool("no-cri", false, "Disable CRI and collect node metrics only")
+	healthPort := fs.Int("health-port", 8090, "Health HTTP server port (0 disables it)")
+	livenessIntervals := fs.Int("liveness-intervals", 3, "Main loop intervals without a finished collection before liveness fails")
+	netExcludePrefixes := fs.String("net-exclude-prefixes", "lo,veth,cali", "Comma-separated interface name prefixes whose counters are left out of the per-interface network breakdown")
+	kubeletRootDir := fs.String("kubelet-root-dir", "/var/lib/kubelet", "Kubelet root directory, its filesystem is always reported")
+	runtimeRootDirs := fs.String("runtime-root-dirs", "/var/lib/containerd,/var/lib/containers/storage,/var/lib/docker", "Comma-separated container runtime root directories, their filesystems are always reported")
+	fsIncludeTypes := fs.String("fs-include-types", defaultFsIncludeTypes, "Comma-separated filesystem type globs reported in disk usage, empty for all")
+	fsExcludeTypes := fs.String("fs-exclude-types", "", "Comma-separated filesystem type globs excluded from disk usage")
+	fsIncludeMountpoints := fs.String("fs-include-mountpoints", "", "Comma-separated mountpoint globs reported in disk usage, empty for all")
+	fsExcludeMountpoints := fs.String("fs-exclude-mountpoints", defaultFsExcludeMountpoints, "Comma-separated mountpoint globs excluded from disk usage (\"/**\" suffix matches a whole tree)")
+	psiTriggerCpu := fs.String("psi-trigger-cpu", "", "CPU PSI trigger \"<some|full> <stall us> <window us>\", empty to disable")
+	psiTriggerMemory := fs.String("psi-trigger-memory", "some 150000 1000000", "Memory PSI trigger \"<some|full> <stall us> <window us>\", empty to disable")
+	psiTriggerIo := fs.String("psi-trigger-io", "", "IO PSI trigger \"<some|full> <stall us> <window us>\", empty to disable")
+	psiTriggerSnapshot := fs.Bool("psi-trigger-snapshot", false, "Take an out-of-cycle snapshot when a PSI trigger fires")
+	labelInclude := fs.String("label-include", "", "Comma-separated key globs of the labels reported, empty for all")
+	labelExclude := fs.String("label-exclude", "", "Comma-separated key globs of the labels excluded")
+	annotationInclude := fs.String("annotation-include", "", "Comma-separated key globs of the annotations reported, empty for all")
+	annotationExclude := fs.String("annotation-exclude", defaultAnnotationExclude, "Comma-separated key globs of the annotations excluded")
+	labelMaxValueLength := fs.Int("label-max-value-length", 256, "Maximum length in bytes of a label or annotation value, 0 for unlimited")
+	podStateFilter := fs.String("pod-state-filter", PodStateReady, "Pod sandboxes collected: ready or all")
+	containerEventsPollInterval := fs.Int("container-events-poll-interval", 1, "Container list polling interval in seconds while the CRI event stream is unavailable, 0 disables it")
 	version := fs.Bool("version", false, "Print the current version and exit")
 
 	return func(logger *zap.Logger) *AgentConfig {
@@ -76,12 +253,113 @@ func RegisterAgentFlags(
 			logger.Fatal("missing required flag: --relay-address")
 		}
 
+		dropPolicy, err := buffer.ParseDropPolicy(*bufferDropPolicy)
+		if err != nil {
+			logger.Fatal("invalid flag: --buffer-drop-policy", zap.Error(err))
+		}
+
+		if *bufferMaxMB <= 0 {
+			logger.Fatal("invalid flag: --buffer-max-mb, must be greater than 0", zap.Int("value", *bufferMaxMB))
+		}
+
+		if *topN < 0 {
+			logger.Fatal("invalid flag: --top-n, must not be negative", zap.Int("value", *topN))
+		}
+
+		if *criTimeout <= 0 {
+			logger.Fatal("invalid flag: --cri-timeout, must be greater than 0", zap.Int("value", *criTimeout))
+		}
+
+		if *criRetryInterval <= 0 {
+			logger.Fatal("invalid flag: --cri-retry-interval, must be greater than 0",
+				zap.Int("value", *criRetryInterval))
+		}
+
+		if *livenessIntervals < 1 {
+			logger.Fatal("invalid flag: --liveness-intervals, must be at least 1",
+				zap.Int("value", *livenessIntervals))
+		}
+
+		if *podStateFilter != PodStateReady && *podStateFilter != PodStateAll {
+			logger.Fatal("invalid flag: --pod-state-filter",
+				zap.String("value", *podStateFilter),
+				zap.Strings("allowed", []string{PodStateReady, PodStateAll}),
+			)
+		}
+
+		var psiTriggers []psi.Trigger
+		for _, t := range []struct{ resource, value string }{
+			{"cpu", *psiTriggerCpu},
+			{"memory", *psiTriggerMemory},
+			{"io", *psiTriggerIo},
+		} {
+			if strings.TrimSpace(t.value) == "" {
+				continue
+			}
+			trigger, err := psi.ParseTrigger(t.resource, t.value)
+			if err != nil {
+				logger.Fatal("invalid flag: --psi-trigger-"+t.resource, zap.Error(err))
+			}
+			psiTriggers = append(psiTriggers, trigger)
+		}
+
 		// Build and return configuration
 		return &AgentConfig{
 			RelayAddress:            *relayAddress,
 			MainLoopDurationSeconds: time.Duration(*mainLoopDuration) * time.Second,
 			BufferRetention:         time.Duration(*bufferRetention) * time.Minute,
+			BufferMaxBytes:          *bufferMaxMB << 20,
+			BufferDropPolicy:        dropPolicy,
+			BufferThinFactor:        *bufferThinFactor,
 			TopN:                    *topN,
+			CriEndpoint:             *criEndpoint,
+			CriSocketPaths:          splitList(*criSocketPaths),
+			HostRoot:                *hostRoot,
+			CriTimeout:              time.Duration(*criTimeout) * time.Second,
+			CriRetryInterval:        time.Duration(*criRetryInterval) * time.Second,
+			NoCri:                   *noCri,
+			HealthPort:              *healthPort,
+			LivenessIntervals:       *livenessIntervals,
+			NetExcludePrefixes:      splitList(*netExcludePrefixes),
+			KubeletRootDir:          *kubeletRootDir,
+			RuntimeRootDirs:         splitList(*runtimeRootDirs),
+			FsIncludeTypes:          splitList(*fsIncludeTypes),
+			FsExcludeTypes:          splitList(*fsExcludeTypes),
+			FsIncludeMountpoints:    splitList(*fsIncludeMountpoints),
+			FsExcludeMountpoints:    splitList(*fsExcludeMountpoints),
+			PsiTriggers:             psiTriggers,
+			PsiTriggerSnapshot:      *psiTriggerSnapshot,
+			LabelFilter: labels.Filter{
+				Include:        splitList(*labelInclude),
+				Exclude:        splitList(*labelExclude),
+				MaxValueLength: *labelMaxValueLength,
+			},
+			AnnotationFilter: labels.Filter{
+				Include:        splitList(*annotationInclude),
+				Exclude:        splitList(*annotationExclude),
+				MaxValueLength: *labelMaxValueLength,
+			},
+			PodStateFilter:      *podStateFilter,
+			ContainerEventsPoll: time.Duration(*containerEventsPollInterval) * time.Second,
+		}
+	}
+}
+
+// splitList splits a comma-separated flag value into its trimmed, non-empty items.
+//
+// Parameters:
+//   - value string: the raw flag value (e.g. "/a.sock, /b.sock").
+//
+// Returns:
+//   - []string: the list of items, or nil if the value is empty.
+func splitList(
+	value string,
+) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
 		}
 	}
+	return items
 }
@@ -0,0 +1,152 @@
+package discovery
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// CriConnector owns the connection to the container runtime.
+//
+// It wraps CriSocketDiscovery so that the agent can start without a container runtime
+// (node-only mode) and pick one up later: Connect performs a single discovery attempt,
+// while Retry keeps attempting in the background until one succeeds.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type CriConnector struct {
+	explicitEndpoint string
+	extraPaths       []string
+	hostRoot         string
+	timeout          time.Duration
+	logger           *zap.Logger
+
+	mu      sync.RWMutex
+	client  cri.RuntimeServiceClient
+	conn    *grpc.ClientConn
+	runtime *CriRuntime
+}
+
+// NewCriConnector creates a CriConnector that is not yet connected.
+//
+// Parameters:
+//   - explicitEndpoint string: endpoint forced by the user, or empty to enable discovery.
+//   - extraPaths []string: additional socket paths to try before the built-in candidates.
+//   - hostRoot string: prefix prepended to every candidate path.
+//   - timeout time.Duration: maximum time allowed for each CRI Version RPC.
+//   - logger *zap.Logger: logger used for discovery and connection messages.
+//
+// Returns:
+//   - *CriConnector: a connector ready for Connect or Retry.
+func NewCriConnector(
+	explicitEndpoint string,
+	extraPaths []string,
+	hostRoot string,
+	timeout time.Duration,
+	logger *zap.Logger,
+) *CriConnector {
+	return &CriConnector{
+		explicitEndpoint: explicitEndpoint,
+		extraPaths:       extraPaths,
+		hostRoot:         hostRoot,
+		timeout:          timeout,
+		logger:           logger,
+	}
+}
+
+// Connect performs a single CRI discovery attempt and, on success, opens the gRPC
+// connection that is later returned by Client.
+//
+// Calling Connect on an already connected CriConnector is a no-op.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the verification RPCs.
+//
+// Returns:
+//   - error: non-nil if no working CRI endpoint was found.
+func (c *CriConnector) Connect(
+	ctx context.Context,
+) error {
+	if c.Client() != nil {
+		return nil
+	}
+
+	runtime, err := CriSocketDiscovery(ctx, c.explicitEndpoint, c.extraPaths, c.hostRoot, c.timeout, c.logger)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Info("CRI socket discovered",
+		zap.String("socket", runtime.Endpoint),
+		zap.String("runtime_name", runtime.RuntimeName),
+		zap.String("runtime_version", runtime.RuntimeVersion),
+		zap.String("runtime_api_version", runtime.RuntimeApiVersion),
+	)
+
+	client, conn := utils.SetupCRIConnection(runtime.Endpoint, c.logger)
+
+	c.mu.Lock()
+	c.client, c.conn, c.runtime = client, conn, runtime
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Retry calls Connect every interval until it succeeds or the context is cancelled.
+//
+// It is meant to be run in its own goroutine while the agent operates in node-only mode.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation stops the retry loop.
+//   - interval time.Duration: delay between two discovery attempts.
+func (c *CriConnector) Retry(
+	ctx context.Context,
+	interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := c.Connect(ctx)
+			if err == nil {
+				c.logger.Info("CRI connection established, leaving node-only mode")
+				return
+			}
+			c.logger.Debug("CRI discovery retry failed", zap.Error(err))
+		}
+	}
+}
+
+// Client returns the CRI runtime client, or nil while no runtime is connected.
+func (c *CriConnector) Client() cri.RuntimeServiceClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client
+}
+
+// Runtime returns the verified runtime description, or nil while no runtime is connected.
+func (c *CriConnector) Runtime() *CriRuntime {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.runtime
+}
+
+// Close closes the underlying gRPC connection, if any.
+func (c *CriConnector) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn != nil {
+		c.logger.Info("closing CRI connection")
+		_ = c.conn.Close()
+	}
+	c.client, c.conn, c.runtime = nil, nil, nil
+}
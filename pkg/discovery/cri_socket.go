@@ -1,42 +1,168 @@
 package discovery
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
-// criSocketCandidates defines a list of known CRI (Container Runtime Interface) socket paths,
-// grouped by runtime type. These are the default installation paths for common runtimes
-// like containerd, CRI-O, and dockershim.
-var criSocketCandidates = map[string][]string{
-	"containerd": {
-		"/run/containerd/containerd.sock",
-		"/var/run/containerd/containerd.sock",
-	},
-	"crio": {
-		"/var/run/crio/crio.sock",
-	},
-	"dockershim": {
-		"/var/run/dockershim.sock",
-	},
+// criSocketCandidate describes a well-known CRI socket path and the runtime expected behind it.
+type criSocketCandidate struct {
+	runtime string // Runtime expected to listen on the socket (informational only)
+	path    string // Absolute path of the Unix socket on the host
+}
+
+// criSocketCandidates defines the ordered list of known CRI (Container Runtime Interface) socket paths.
+// These are the default installation paths for common runtimes like containerd, CRI-O, cri-dockerd
+// and dockershim. The order matters: the first candidate that answers the CRI Version RPC wins,
+// so the legacy dockershim socket is deliberately checked last.
+var criSocketCandidates = []criSocketCandidate{
+	{runtime: "containerd", path: "/run/containerd/containerd.sock"},
+	{runtime: "containerd", path: "/var/run/containerd/containerd.sock"},
+	{runtime: "crio", path: "/run/crio/crio.sock"},
+	{runtime: "crio", path: "/var/run/crio/crio.sock"},
+	{runtime: "cri-dockerd", path: "/run/cri-dockerd.sock"},
+	{runtime: "cri-dockerd", path: "/var/run/cri-dockerd.sock"},
+	{runtime: "dockershim", path: "/var/run/dockershim.sock"},
+}
+
+// CriRuntime describes a CRI endpoint that has been verified to answer the CRI Version RPC.
+type CriRuntime struct {
+	Endpoint          string // Full URI of the endpoint (e.g., "unix:///run/containerd/containerd.sock")
+	RuntimeName       string // Name of the container runtime (e.g., "containerd")
+	RuntimeVersion    string // Version of the container runtime (e.g., "v1.7.22")
+	RuntimeApiVersion string // CRI API version implemented by the runtime (e.g., "v1")
 }
 
-// CriSocketDiscovery attempts to discover a valid CRI Unix socket on the host.
+// CriSocketDiscovery attempts to discover a working CRI endpoint on the host.
+//
+// If explicitEndpoint is set, it is the only endpoint considered: it is normalized to a
+// "unix://" URI when given as a bare path and verified, and an error is returned if it does
+// not answer. Otherwise, the function walks the ordered candidate list made of extraPaths
+// (checked first, in the given order) followed by the built-in well-known socket paths.
+// Every candidate path is prefixed with hostRoot, which allows a containerized agent to
+// find the host sockets mounted under e.g. "/host".
 //
-// It iterates over a predefined list of well-known socket paths used by popular
-// container runtimes (e.g., containerd, CRI-O, dockershim). The function checks for
-// the existence of each socket file and returns the first match found, prefixed with "unix://".
+// A candidate is accepted only if it is a Unix socket and the runtime behind it answers
+// the CRI Version RPC within the given timeout. This prevents stale sockets (e.g., a
+// leftover dockershim socket) from being selected.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the verification RPCs.
+//   - explicitEndpoint string: endpoint forced by the user, or empty to enable discovery.
+//   - extraPaths []string: additional socket paths to try before the built-in candidates.
+//   - hostRoot string: prefix prepended to every candidate path (empty or "/" for none).
+//   - timeout time.Duration: maximum time allowed for each Version RPC.
+//   - logger *zap.Logger: logger used to trace rejected candidates.
 //
 // Returns:
-//   - string: the full URI to the discovered socket (e.g., "unix:///var/run/containerd/containerd.sock")
-//   - error: non-nil if no known CRI sockets were found or accessible
-func CriSocketDiscovery() (string, error) {
-	for _, paths := range criSocketCandidates {
-		for _, p := range paths {
-			if fi, err := os.Stat(p); err == nil && !fi.IsDir() {
-				return "unix://" + p, nil
-			}
+//   - *CriRuntime: the verified endpoint together with the runtime name and version.
+//   - error: non-nil if no candidate answered the CRI Version RPC.
+func CriSocketDiscovery(
+	ctx context.Context,
+	explicitEndpoint string,
+	extraPaths []string,
+	hostRoot string,
+	timeout time.Duration,
+	logger *zap.Logger,
+) (*CriRuntime, error) {
+	if explicitEndpoint != "" {
+		endpoint := normalizeEndpoint(explicitEndpoint)
+		runtime, err := verifyCriEndpoint(ctx, endpoint, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("CRI endpoint %s is not usable: %w", endpoint, err)
+		}
+		return runtime, nil
+	}
+
+	candidates := make([]criSocketCandidate, 0, len(extraPaths)+len(criSocketCandidates))
+	for _, p := range extraPaths {
+		candidates = append(candidates, criSocketCandidate{runtime: "custom", path: p})
+	}
+	candidates = append(candidates, criSocketCandidates...)
+
+	var tried []string
+	for _, c := range candidates {
+		path := filepath.Join("/", hostRoot, c.path)
+
+		fi, err := os.Stat(path)
+		if err != nil || fi.Mode()&os.ModeSocket == 0 {
+			continue
+		}
+		tried = append(tried, path)
+
+		endpoint := "unix://" + path
+		runtime, err := verifyCriEndpoint(ctx, endpoint, timeout)
+		if err != nil {
+			logger.Warn("CRI socket candidate rejected",
+				zap.String("socket", endpoint),
+				zap.String("expected_runtime", c.runtime),
+				zap.Error(err),
+			)
+			continue
 		}
+		return runtime, nil
+	}
+
+	if len(tried) == 0 {
+		return nil, fmt.Errorf("no known CRI sockets found")
+	}
+	return nil, fmt.Errorf("no CRI socket answered the Version RPC (tried: %s)", strings.Join(tried, ", "))
+}
+
+// verifyCriEndpoint opens a short-lived gRPC connection to the given endpoint and calls the
+// CRI Version RPC to make sure a live runtime is listening behind it.
+//
+// Parameters:
+//   - ctx context.Context: parent context for the RPC.
+//   - endpoint string: full URI of the endpoint (e.g., "unix:///run/containerd/containerd.sock").
+//   - timeout time.Duration: maximum time allowed for the Version RPC.
+//
+// Returns:
+//   - *CriRuntime: the endpoint together with the runtime name and version reported by the runtime.
+//   - error: non-nil if the connection could not be created or the RPC failed.
+func verifyCriEndpoint(
+	ctx context.Context,
+	endpoint string,
+	timeout time.Duration,
+) (*CriRuntime, error) {
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = conn.Close() }()
+
+	versionCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := cri.NewRuntimeServiceClient(conn).Version(versionCtx, &cri.VersionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CriRuntime{
+		Endpoint:          endpoint,
+		RuntimeName:       resp.RuntimeName,
+		RuntimeVersion:    resp.RuntimeVersion,
+		RuntimeApiVersion: resp.RuntimeApiVersion,
+	}, nil
+}
+
+// normalizeEndpoint turns a bare socket path into a "unix://" URI.
+// Endpoints that already carry a scheme (e.g., "unix://", "tcp://") are returned unchanged.
+func normalizeEndpoint(
+	endpoint string,
+) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
 	}
-	return "", fmt.Errorf("no known CRI sockets found")
+	return "unix://" + endpoint
 }
@@ -0,0 +1,261 @@
+package events
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// Event types reported in NodeEvent.type.
+const (
+	TypeOomKill         = "oom_kill"
+	TypeHungTask        = "hung_task"
+	TypeSoftLockup      = "soft_lockup"
+	TypeFilesystemError = "filesystem_error"
+	TypeLinkDown        = "link_down"
+	TypeLinkUp          = "link_up"
+	TypePsiTrigger      = "psi_trigger"
+)
+
+// maxMessageLength bounds the length of the raw kernel messages attached to events.
+const maxMessageLength = 1024
+
+var (
+	// oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=...,oom_memcg=...,task_memcg=...,task=stress,pid=1234,uid=0
+	oomKillInfoPattern = regexp.MustCompile(`^oom-kill:(.*)$`)
+
+	// Task in /kubepods/burstable/pod.../... killed as a result of limit of /kubepods/burstable/pod... (kernels < 4.19)
+	oomTaskInPattern = regexp.MustCompile(`^Task in (\S+) killed as a result of limit of (\S+)`)
+
+	// Memory cgroup out of memory: Killed process 1234 (stress) total-vm:..., anon-rss:102400kB, ...
+	// Out of memory: Killed process 1234 (stress) total-vm:..., anon-rss:102400kB, ...
+	oomKilledPattern = regexp.MustCompile(`(?:Memory cgroup out of memory|Out of memory)[^:]*: Kill(?:ed)? process (\d+) \(([^)]*)\)(.*)$`)
+	anonRssPattern   = regexp.MustCompile(`anon-rss:(\d+)kB`)
+
+	// INFO: task kworker/0:1:123 blocked for more than 120 seconds.
+	hungTaskPattern = regexp.MustCompile(`^INFO: task (.+):(\d+) blocked for more than (\d+) seconds`)
+
+	// watchdog: BUG: soft lockup - CPU#3 stuck for 22s! [stress:1234]
+	softLockupPattern = regexp.MustCompile(`soft lockup - CPU#(\d+) stuck for (\d+)s! \[(.+):(\d+)\]`)
+
+	// EXT4-fs error (device sda1): ..., BTRFS error (device sda1): ..., XFS (sda1): Corruption detected. ...
+	ext4ErrorPattern  = regexp.MustCompile(`^EXT4-fs error \(device ([^)]+)\)`)
+	btrfsErrorPattern = regexp.MustCompile(`^BTRFS (?:error|critical) \(device ([^)]+)\)`)
+	xfsPattern        = regexp.MustCompile(`^XFS \(([^)]+)\): (.*)$`)
+
+	// e1000e: eth0 NIC Link is Down, ixgbe 0000:03:00.0 eth2: NIC Link is Up 10 Gbps, mlx5_core 0000:5e:00.0 ens1f0: Link down,
+	// r8169 0000:02:00.0 eth0: Link is Up - 1Gbps/Full
+	linkPattern = regexp.MustCompile(`(?i)(?:^|\s)(\S+?):? (?:NIC Link is|Link is|Link) (up|down)\b`)
+)
+
+// record is a /dev/kmsg record.
+type record struct {
+	priority  uint32
+	timestamp time.Duration // Since boot
+	message   string
+}
+
+// parseRecord parses a /dev/kmsg record: "<prefix>,<seq>,<timestamp us>,<flags>[,...];<message>",
+// optionally followed by continuation lines starting with a space (device metadata), which are ignored.
+//
+// Parameters:
+//   - data string: the record, as returned by a single read of /dev/kmsg.
+//
+// Returns:
+//   - record: the parsed record.
+//   - bool: false if the record is malformed.
+func parseRecord(
+	data string,
+) (record, bool) {
+	header, message, ok := strings.Cut(data, ";")
+	if !ok {
+		return record{}, false
+	}
+	message, _, _ = strings.Cut(message, "\n")
+
+	fields := strings.Split(header, ",")
+	if len(fields) < 3 {
+		return record{}, false
+	}
+
+	prefix, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return record{}, false
+	}
+	usec, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return record{}, false
+	}
+
+	return record{
+		priority:  uint32(prefix & 7), // The facility is in the upper bits
+		timestamp: time.Duration(usec) * time.Microsecond,
+		message:   message,
+	}, true
+}
+
+// parser turns kernel log records into NodeEvent messages.
+//
+// The OOM killer logs a victim over several records: the "oom-kill:" summary (or, on older
+// kernels, the "Task in ... killed" line) carries the memory cgroup, and the following
+// "Killed process" record the victim itself. The parser keeps the cgroup details of the
+// last summary and attaches them to the next victim.
+//
+// A parser is not safe for concurrent use.
+type parser struct {
+	bootTime  time.Time
+	oomInfo   map[string]string // Key/value pairs of the last "oom-kill:" record
+	oomTaskIn string            // Memory cgroup of the last "Task in" record
+}
+
+// parse converts a record into an event.
+//
+// Parameters:
+//   - rec record: the kernel log record.
+//
+// Returns:
+//   - *gen.NodeEvent: the event, or nil if the record does not describe a reported event.
+func (p *parser) parse(
+	rec record,
+) *gen.NodeEvent {
+	msg := rec.message
+
+	if m := oomKillInfoPattern.FindStringSubmatch(msg); m != nil {
+		p.oomInfo = parseKeyValues(m[1])
+		return nil
+	}
+	if m := oomTaskInPattern.FindStringSubmatch(msg); m != nil {
+		p.oomTaskIn = m[1]
+		return nil
+	}
+
+	event := &gen.NodeEvent{
+		Timestamp: p.bootTime.Add(rec.timestamp).UnixNano(),
+		Message:   truncateMessage(msg),
+		Priority:  rec.priority,
+		Count:     1,
+	}
+
+	if m := oomKilledPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeOomKill
+		event.Pid = parsePid(m[1])
+		event.ProcessName = m[2]
+		event.Attributes = map[string]string{}
+		if rss := anonRssPattern.FindStringSubmatch(m[3]); rss != nil {
+			if kb, err := strconv.ParseUint(rss[1], 10, 64); err == nil {
+				event.Attributes["anon_rss_bytes"] = strconv.FormatUint(kb*1024, 10)
+			}
+		}
+
+		if p.oomInfo != nil && p.oomInfo["pid"] == m[1] {
+			event.Cgroup = p.oomInfo["task_memcg"]
+			if constraint := p.oomInfo["constraint"]; constraint != "" {
+				event.Attributes["constraint"] = constraint
+			}
+		} else if p.oomTaskIn != "" {
+			event.Cgroup = p.oomTaskIn
+		}
+		p.oomInfo, p.oomTaskIn = nil, ""
+
+		ref := cgroup.ParseRef(event.Cgroup)
+		event.PodUid = ref.PodUID
+		event.ContainerId = ref.ContainerID
+		return event
+	}
+
+	if m := hungTaskPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeHungTask
+		event.ProcessName = m[1]
+		event.Pid = parsePid(m[2])
+		event.Attributes = map[string]string{"blocked_seconds": m[3]}
+		return event
+	}
+
+	if m := softLockupPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeSoftLockup
+		event.ProcessName = m[3]
+		event.Pid = parsePid(m[4])
+		event.Attributes = map[string]string{"cpu": m[1], "stuck_seconds": m[2]}
+		return event
+	}
+
+	if m := ext4ErrorPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeFilesystemError
+		event.Device = m[1]
+		event.Attributes = map[string]string{"fstype": "ext4"}
+		return event
+	}
+
+	if m := btrfsErrorPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeFilesystemError
+		event.Device = m[1]
+		event.Attributes = map[string]string{"fstype": "btrfs"}
+		return event
+	}
+
+	if m := xfsPattern.FindStringSubmatch(msg); m != nil && isXfsError(m[2]) {
+		event.Type = TypeFilesystemError
+		event.Device = m[1]
+		event.Attributes = map[string]string{"fstype": "xfs"}
+		return event
+	}
+
+	if m := linkPattern.FindStringSubmatch(msg); m != nil {
+		event.Type = TypeLinkUp
+		if strings.EqualFold(m[2], "down") {
+			event.Type = TypeLinkDown
+		}
+		event.Device = m[1]
+		return event
+	}
+
+	return nil
+}
+
+// isXfsError reports whether an XFS message denotes an error rather than, e.g., a mount notice.
+func isXfsError(
+	msg string,
+) bool {
+	msg = strings.ToLower(msg)
+	for _, keyword := range []string{"error", "corrupt", "shut down", "shutdown"} {
+		if strings.Contains(msg, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseKeyValues parses a comma-separated list of key=value pairs (e.g., the "oom-kill:" summary).
+func parseKeyValues(
+	s string,
+) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if key, value, ok := strings.Cut(pair, "="); ok {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// parsePid parses a PID, returning 0 if it is not a valid number.
+func parsePid(
+	s string,
+) int32 {
+	pid, _ := strconv.ParseInt(s, 10, 32)
+	return int32(pid)
+}
+
+// truncateMessage bounds the length of a kernel message and makes it valid UTF-8.
+func truncateMessage(
+	msg string,
+) string {
+	if len(msg) > maxMessageLength {
+		msg = msg[:maxMessageLength]
+	}
+	return strings.ToValidUTF8(msg, "�")
+}
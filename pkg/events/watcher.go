@@ -0,0 +1,228 @@
+// Package events detects kernel events of the node (OOM kills, hung tasks, soft lockups,
+// filesystem errors, NIC link flaps) by tailing the kernel log, and buffers them together
+// with the events of other sources until the next snapshot.
+package events
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"github.com/shirou/gopsutil/v3/host"
+	"go.uber.org/zap"
+)
+
+const (
+	// kmsgPath is the kernel log device. In a container it must be mounted from the host.
+	kmsgPath = "/dev/kmsg"
+
+	// maxPendingEvents bounds the events kept between two snapshots; the oldest are dropped beyond it.
+	maxPendingEvents = 1024
+
+	// maxRecordSize is the maximum size of a /dev/kmsg record.
+	maxRecordSize = 8192
+)
+
+// Watcher tails /dev/kmsg in the background and accumulates the parsed events until the
+// next snapshot drains them.
+//
+// If the kernel log cannot be read (e.g., /dev/kmsg not mounted or not readable), the
+// watcher falls back to the oom_kill counter of /proc/vmstat: each drain then reports
+// one aggregated OOM kill event with the number of kills since the previous drain,
+// without process or pod attribution. The other event types are not available in that mode.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type Watcher struct {
+	mu       sync.Mutex
+	events   []*gen.NodeEvent
+	dropped  uint64
+	fallback bool
+	oomKills uint64
+	parser   parser
+	logger   *zap.Logger
+}
+
+// NewWatcher creates a Watcher. Events are only collected once Run is started; until then,
+// and if Run fails, Drain uses the /proc/vmstat fallback.
+//
+// Parameters:
+//   - logger *zap.Logger: logger for the kernel log access warnings.
+//
+// Returns:
+//   - *Watcher: the watcher.
+func NewWatcher(
+	logger *zap.Logger,
+) *Watcher {
+	w := &Watcher{logger: logger}
+
+	if bootTime, err := host.BootTime(); err == nil {
+		w.parser.bootTime = time.Unix(int64(bootTime), 0)
+	}
+	w.startFallback()
+
+	return w
+}
+
+// Run tails /dev/kmsg until ctx is cancelled, starting from the end of the log so that events
+// logged before the agent started are not reported again after a restart.
+//
+// It blocks and is meant to be run in its own goroutine. If the kernel log cannot be opened
+// or read, it logs a warning, switches the watcher to the /proc/vmstat fallback and returns.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation stops the watcher.
+func (w *Watcher) Run(
+	ctx context.Context,
+) {
+	file, err := os.Open(kmsgPath)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekEnd)
+	}
+	if err != nil {
+		w.logger.Warn("kernel log unavailable, OOM kills are counted from /proc/vmstat without attribution",
+			zap.String("path", kmsgPath), zap.Error(err))
+		if file != nil {
+			_ = file.Close()
+		}
+		return
+	}
+
+	w.mu.Lock()
+	w.fallback = false
+	w.mu.Unlock()
+
+	w.logger.Info("watching kernel log for node events", zap.String("path", kmsgPath))
+
+	// Unblocks the pending read when the context is cancelled.
+	stop := context.AfterFunc(ctx, func() { _ = file.Close() })
+	defer stop()
+
+	buf := make([]byte, maxRecordSize)
+	for {
+		n, err := file.Read(buf)
+		if err != nil {
+			if errors.Is(err, syscall.EPIPE) {
+				// Records were overwritten in the ring buffer before being read: continue with the next one.
+				continue
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			w.logger.Warn("failed to read kernel log, OOM kills are counted from /proc/vmstat from now on",
+				zap.Error(err))
+			_ = file.Close()
+			w.startFallback()
+			return
+		}
+
+		rec, ok := parseRecord(string(buf[:n]))
+		if !ok {
+			continue
+		}
+		if event := w.parser.parse(rec); event != nil {
+			w.Add(event)
+		}
+	}
+}
+
+// Drain returns the events detected since the previous call and clears them.
+//
+// Returns:
+//   - []*gen.NodeEvent: the events, oldest first, or nil if none.
+func (w *Watcher) Drain() []*gen.NodeEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.fallback {
+		if kills, err := readOomKills(); err == nil {
+			if kills > w.oomKills {
+				w.appendLocked(&gen.NodeEvent{
+					Type:      TypeOomKill,
+					Timestamp: time.Now().UnixNano(),
+					Count:     kills - w.oomKills,
+				})
+			}
+			w.oomKills = kills
+		}
+	}
+
+	if w.dropped > 0 {
+		w.logger.Warn("node events dropped, too many events between two snapshots",
+			zap.Uint64("dropped", w.dropped), zap.Int("max_pending_events", maxPendingEvents))
+		w.dropped = 0
+	}
+
+	events := w.events
+	w.events = nil
+	return events
+}
+
+// Add appends an event detected by another source (e.g., a PSI trigger) to the pending events,
+// so that it is reported in the next snapshot together with the kernel log events.
+//
+// Parameters:
+//   - event *gen.NodeEvent: the event to report.
+func (w *Watcher) Add(
+	event *gen.NodeEvent,
+) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.appendLocked(event)
+}
+
+// appendLocked appends an event, dropping the oldest one beyond maxPendingEvents. Must be called with w.mu held.
+func (w *Watcher) appendLocked(
+	event *gen.NodeEvent,
+) {
+	if len(w.events) >= maxPendingEvents {
+		w.events = w.events[1:]
+		w.dropped++
+	}
+	w.events = append(w.events, event)
+}
+
+// startFallback switches the watcher to the /proc/vmstat fallback, taking the current number of
+// OOM kills as the baseline so that kills already reported from the kernel log are not counted again.
+func (w *Watcher) startFallback() {
+	kills, _ := readOomKills()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.fallback = true
+	w.oomKills = kills
+}
+
+// readOomKills reads the oom_kill counter of /proc/vmstat (Linux 4.13+).
+//
+// Returns:
+//   - uint64: the number of OOM kills since boot.
+//   - error: non-nil if the file cannot be read or has no oom_kill counter.
+func readOomKills() (uint64, error) {
+	file, err := os.Open(utils.HostProc("vmstat"))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if value, ok := strings.CutPrefix(scanner.Text(), "oom_kill "); ok {
+			return strconv.ParseUint(value, 10, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, errors.New("no oom_kill counter in /proc/vmstat")
+}
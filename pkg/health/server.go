@@ -0,0 +1,75 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/buildinfo"
+	"go.uber.org/zap"
+)
+
+// Serve starts the health HTTP server on the given port and blocks until the context
+// is cancelled, then shuts the server down gracefully.
+//
+// The following endpoints are exposed:
+//   - /healthz: 200 if a collection cycle finished recently (liveness), 503 otherwise.
+//   - /readyz:  200 if the CRI runtime is connected (when required) and the relay stream is open, 503 otherwise.
+//   - /statusz: JSON document describing buffer fill, collector errors and send state.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation stops the server.
+//   - port int: TCP port to listen on (all interfaces).
+//   - state *State: health state read by the endpoints.
+//   - logger *zap.Logger: logger used for server lifecycle messages.
+func Serve(
+	ctx context.Context,
+	port int,
+	state *State,
+	logger *zap.Logger,
+) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", probeHandler(state.Alive))
+	mux.HandleFunc("/readyz", probeHandler(state.Ready))
+	mux.HandleFunc("/statusz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(state.Snapshot(buildinfo.Version))
+	})
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("health server listening", zap.String("address", server.Addr))
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error("health server failed", zap.Error(err))
+	}
+}
+
+// probeHandler builds an HTTP handler answering 200 "ok" when check succeeds
+// and 503 with the failure reason otherwise.
+func probeHandler(
+	check func() (bool, string),
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		if ok, reason := check(); !ok {
+			http.Error(w, reason, http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok\n"))
+	}
+}
@@ -0,0 +1,320 @@
+package health
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/buffer"
+	"github.com/kubensage/kubensage-agent/pkg/discovery"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+)
+
+// unlabelledCollector is the collector name used for errors that do not carry a
+// *utils.CollectorError label.
+const unlabelledCollector = "unknown"
+
+// State tracks the runtime health of the agent: when collection cycles finish, which
+// collectors failed, whether the relay stream is open and how full the buffer is
+// (in bytes versus its byte budget).
+//
+// The main loop records events into State and the HTTP endpoints read from it.
+// All methods are safe for concurrent use by multiple goroutines.
+type State struct {
+	mu sync.RWMutex
+
+	startedAt         time.Time
+	interval          time.Duration
+	livenessIntervals int
+	criRequired       bool
+
+	criConnected bool
+	criRuntime   discovery.CriRuntime
+
+	collectCycles       uint64
+	lastCollectAt       time.Time
+	lastCollectDuration time.Duration
+	collectorErrors     map[string]*CollectorErrorStatus
+
+	streamOpen      bool
+	sendCycles      uint64
+	sendFailures    uint64
+	reconnects      uint64
+	lastSendAt      time.Time
+	lastSendError   string
+	lastSendErrorAt time.Time
+
+	buffer buffer.Stats
+}
+
+// Counters is a point-in-time copy of the agent counters, used to build self-telemetry.
+type Counters struct {
+	Buffer       buffer.Stats // Buffer fill level and overflow counters
+	SendFailures uint64       // Failed send cycles
+	Reconnects   uint64       // Relay stream re-opens after a failure
+}
+
+// CollectorErrorStatus reports the last error of a collector and how many times it failed.
+type CollectorErrorStatus struct {
+	Error string    `json:"error"`
+	At    time.Time `json:"at"`
+	Count uint64    `json:"count"`
+}
+
+// NewState creates a State for an agent that collects every interval.
+//
+// Parameters:
+//   - interval time.Duration: duration of the main collection loop.
+//   - livenessIntervals int: number of intervals without a finished collection after which
+//     the agent is considered not alive.
+//   - criRequired bool: whether readiness requires a CRI connection (false in --no-cri mode).
+//
+// Returns:
+//   - *State: an empty state whose start time is now.
+func NewState(
+	interval time.Duration,
+	livenessIntervals int,
+	criRequired bool,
+) *State {
+	return &State{
+		startedAt:         time.Now(),
+		interval:          interval,
+		livenessIntervals: livenessIntervals,
+		criRequired:       criRequired,
+		collectorErrors:   make(map[string]*CollectorErrorStatus),
+	}
+}
+
+// RecordCollect records the end of a collection cycle and the errors it produced.
+//
+// Errors labelled with *utils.CollectorError are grouped by collector name; other errors
+// are grouped under "unknown".
+//
+// Parameters:
+//   - duration time.Duration: time taken by the collection cycle.
+//   - errs []error: errors returned by the collection cycle. May be nil.
+func (s *State) RecordCollect(
+	duration time.Duration,
+	errs []error,
+) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collectCycles++
+	s.lastCollectAt = now
+	s.lastCollectDuration = duration
+
+	for _, err := range errs {
+		collector := unlabelledCollector
+		var collectorErr *utils.CollectorError
+		if errors.As(err, &collectorErr) {
+			collector = collectorErr.Collector
+		}
+
+		status, ok := s.collectorErrors[collector]
+		if !ok {
+			status = &CollectorErrorStatus{}
+			s.collectorErrors[collector] = status
+		}
+		status.Error = err.Error()
+		status.At = now
+		status.Count++
+	}
+}
+
+// RecordSend records the outcome of a send cycle and whether the relay stream is still open.
+//
+// A stream that becomes open again after a previous send cycle left it closed is
+// counted as a reconnect; a non-nil error is counted as a send failure.
+//
+// Parameters:
+//   - streamOpen bool: whether the relay stream is usable after the send cycle.
+//   - err error: the send error, or nil if the send cycle succeeded.
+func (s *State) RecordSend(
+	streamOpen bool,
+	err error,
+) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if streamOpen && !s.streamOpen && s.sendCycles > 0 {
+		s.reconnects++
+	}
+	s.sendCycles++
+	s.streamOpen = streamOpen
+	if err != nil {
+		s.sendFailures++
+		s.lastSendError = err.Error()
+		s.lastSendErrorAt = now
+		return
+	}
+	s.lastSendAt = now
+}
+
+// RecordCri records the CRI runtime currently connected, or nil while none is.
+func (s *State) RecordCri(
+	runtime *discovery.CriRuntime,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.criConnected = runtime != nil
+	s.criRuntime = discovery.CriRuntime{}
+	if runtime != nil {
+		s.criRuntime = *runtime
+	}
+}
+
+// RecordBuffer records the current fill level and overflow counters of the metrics buffer.
+func (s *State) RecordBuffer(
+	stats buffer.Stats,
+) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buffer = stats
+}
+
+// Counters returns a point-in-time copy of the agent counters.
+func (s *State) Counters() Counters {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return Counters{
+		Buffer:       s.buffer,
+		SendFailures: s.sendFailures,
+		Reconnects:   s.reconnects,
+	}
+}
+
+// Alive reports whether a collection cycle finished within the last livenessIntervals
+// intervals. Before the first cycle, the agent start time is used as reference.
+//
+// Returns:
+//   - bool: true if the agent is alive.
+//   - string: a human-readable reason when the agent is not alive.
+func (s *State) Alive() (bool, string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	last := s.lastCollectAt
+	if last.IsZero() {
+		last = s.startedAt
+	}
+
+	maxAge := time.Duration(s.livenessIntervals) * s.interval
+	if age := time.Since(last); age > maxAge {
+		return false, "no collection cycle finished in " + age.Truncate(time.Second).String()
+	}
+	return true, ""
+}
+
+// Ready reports whether the agent is connected to the CRI runtime (when required)
+// and has an open relay stream.
+//
+// Returns:
+//   - bool: true if the agent is ready.
+//   - string: a human-readable reason when the agent is not ready.
+func (s *State) Ready() (bool, string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.criRequired && !s.criConnected {
+		return false, "CRI runtime not connected"
+	}
+	if !s.streamOpen {
+		return false, "relay stream not open"
+	}
+	return true, ""
+}
+
+// Status is the JSON document served by the /statusz endpoint.
+type Status struct {
+	StartedAt time.Time `json:"started_at"`
+	Uptime    string    `json:"uptime"`
+	Version   string    `json:"version"`
+
+	Alive       bool   `json:"alive"`
+	AliveReason string `json:"alive_reason,omitempty"`
+	Ready       bool   `json:"ready"`
+	ReadyReason string `json:"ready_reason,omitempty"`
+
+	CriRequired       bool   `json:"cri_required"`
+	CriConnected      bool   `json:"cri_connected"`
+	CriEndpoint       string `json:"cri_endpoint,omitempty"`
+	CriRuntimeName    string `json:"cri_runtime_name,omitempty"`
+	CriRuntimeVersion string `json:"cri_runtime_version,omitempty"`
+
+	CollectCycles       uint64                           `json:"collect_cycles"`
+	LastCollectAt       time.Time                        `json:"last_collect_at"`
+	LastCollectDuration string                           `json:"last_collect_duration"`
+	CollectorErrors     map[string]*CollectorErrorStatus `json:"collector_errors"`
+
+	StreamOpen      bool      `json:"stream_open"`
+	SendFailures    uint64    `json:"send_failures"`
+	Reconnects      uint64    `json:"reconnects"`
+	LastSendAt      time.Time `json:"last_send_at"`
+	LastSendError   string    `json:"last_send_error,omitempty"`
+	LastSendErrorAt time.Time `json:"last_send_error_at"`
+
+	BufferLen               int    `json:"buffer_len"`
+	BufferBytes             int    `json:"buffer_bytes"`
+	BufferMaxBytes          int    `json:"buffer_max_bytes"`
+	BufferDroppedSnapshots  uint64 `json:"buffer_dropped_snapshots"`
+	BufferStrippedSnapshots uint64 `json:"buffer_stripped_snapshots"`
+}
+
+// Snapshot returns a consistent copy of the state for reporting.
+//
+// Parameters:
+//   - version string: agent version to include in the status.
+//
+// Returns:
+//   - *Status: the status document.
+func (s *State) Snapshot(
+	version string,
+) *Status {
+	alive, aliveReason := s.Alive()
+	ready, readyReason := s.Ready()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	collectorErrors := make(map[string]*CollectorErrorStatus, len(s.collectorErrors))
+	for name, status := range s.collectorErrors {
+		c := *status
+		collectorErrors[name] = &c
+	}
+
+	return &Status{
+		StartedAt:               s.startedAt,
+		Uptime:                  time.Since(s.startedAt).Truncate(time.Second).String(),
+		Version:                 version,
+		Alive:                   alive,
+		AliveReason:             aliveReason,
+		Ready:                   ready,
+		ReadyReason:             readyReason,
+		CriRequired:             s.criRequired,
+		CriConnected:            s.criConnected,
+		CriEndpoint:             s.criRuntime.Endpoint,
+		CriRuntimeName:          s.criRuntime.RuntimeName,
+		CriRuntimeVersion:       s.criRuntime.RuntimeVersion,
+		CollectCycles:           s.collectCycles,
+		LastCollectAt:           s.lastCollectAt,
+		LastCollectDuration:     s.lastCollectDuration.String(),
+		CollectorErrors:         collectorErrors,
+		StreamOpen:              s.streamOpen,
+		SendFailures:            s.sendFailures,
+		Reconnects:              s.reconnects,
+		LastSendAt:              s.lastSendAt,
+		LastSendError:           s.lastSendError,
+		LastSendErrorAt:         s.lastSendErrorAt,
+		BufferLen:               s.buffer.Len,
+		BufferBytes:             s.buffer.Bytes,
+		BufferMaxBytes:          s.buffer.MaxBytes,
+		BufferDroppedSnapshots:  s.buffer.DroppedSnapshots,
+		BufferStrippedSnapshots: s.buffer.StrippedSnapshots,
+	}
+}
@@ -0,0 +1,131 @@
+// Package labels selects the pod and container labels and annotations reported in the snapshots.
+package labels
+
+import (
+	"unicode/utf8"
+)
+
+// Filter selects the keys of a label or annotation map and bounds the length of their values,
+// to control the cardinality of the exported series and the leakage of sensitive metadata.
+//
+// Keys are matched against globs where "*" matches any sequence of characters, "/" included
+// (e.g., "app.kubernetes.io/*" or "*.secret"), and "?" matches a single character.
+type Filter struct {
+	Include        []string // Key globs reported (empty means all)
+	Exclude        []string // Key globs left out, even if included
+	MaxValueLength int      // Maximum value length in bytes, longer values are truncated (0 means unlimited)
+}
+
+// Apply returns the entries of values selected by the filter, with the values truncated to
+// MaxValueLength bytes on a UTF-8 boundary.
+//
+// Parameters:
+//   - values map[string]string: the labels or annotations reported by the runtime (may be nil).
+//
+// Returns:
+//   - map[string]string: a new map with the selected entries, or nil if none is selected.
+func (f *Filter) Apply(
+	values map[string]string,
+) map[string]string {
+	var selected map[string]string
+
+	for key, value := range values {
+		if len(f.Include) > 0 && !matchAny(f.Include, key) {
+			continue
+		}
+		if matchAny(f.Exclude, key) {
+			continue
+		}
+
+		if selected == nil {
+			selected = make(map[string]string)
+		}
+		selected[key] = truncate(value, f.MaxValueLength)
+	}
+
+	return selected
+}
+
+// matchAny reports whether key matches one of the globs.
+//
+// Parameters:
+//   - globs []string: the key globs.
+//   - key string: the label or annotation key.
+//
+// Returns:
+//   - bool: true if a glob matches the whole key.
+func matchAny(
+	globs []string,
+	key string,
+) bool {
+	for _, glob := range globs {
+		if match(glob, key) {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the whole key matches glob, where "*" matches any sequence of
+// characters and "?" a single character. Unlike path.Match, "*" also matches "/", which
+// separates the prefix of Kubernetes label keys.
+//
+// Parameters:
+//   - glob string: the key glob.
+//   - key string: the label or annotation key.
+//
+// Returns:
+//   - bool: true if the glob matches.
+func match(
+	glob string,
+	key string,
+) bool {
+	// Iterative matching with backtracking to the last "*".
+	g, k := 0, 0
+	star, starKey := -1, 0
+
+	for k < len(key) {
+		switch {
+		case g < len(glob) && (glob[g] == '?' || glob[g] == key[k]):
+			g++
+			k++
+		case g < len(glob) && glob[g] == '*':
+			star, starKey = g, k
+			g++
+		case star >= 0:
+			starKey++
+			g, k = star+1, starKey
+		default:
+			return false
+		}
+	}
+
+	for g < len(glob) && glob[g] == '*' {
+		g++
+	}
+	return g == len(glob)
+}
+
+// truncate shortens value to at most maxLength bytes without splitting a UTF-8 sequence,
+// as protobuf strings must be valid UTF-8.
+//
+// Parameters:
+//   - value string: the value to truncate.
+//   - maxLength int: the maximum length in bytes, 0 or less for unlimited.
+//
+// Returns:
+//   - string: the value, truncated if longer than maxLength.
+func truncate(
+	value string,
+	maxLength int,
+) string {
+	if maxLength <= 0 || len(value) <= maxLength {
+		return value
+	}
+
+	end := maxLength
+	for end > 0 && !utf8.RuneStart(value[end]) {
+		end--
+	}
+	return value[:end]
+}
@@ -0,0 +1,120 @@
+package lifecycle
+
+import (
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// Labels set by kubelet on every container, identifying its pod.
+const (
+	podUidLabel       = "io.kubernetes.pod.uid"
+	podNameLabel      = "io.kubernetes.pod.name"
+	podNamespaceLabel = "io.kubernetes.pod.namespace"
+)
+
+// identityFromContainer builds the identity fields of the events of a listed container.
+//
+// Parameters:
+//   - c *cri.Container: the container as listed by the runtime.
+//
+// Returns:
+//   - *gen.ContainerEvent: an event with only the container and pod identity set.
+func identityFromContainer(
+	c *cri.Container,
+) *gen.ContainerEvent {
+	identity := &gen.ContainerEvent{
+		ContainerId:  c.Id,
+		PodSandboxId: c.PodSandboxId,
+		PodUid:       c.Labels[podUidLabel],
+		PodName:      c.Labels[podNameLabel],
+		PodNamespace: c.Labels[podNamespaceLabel],
+	}
+	if c.Metadata != nil {
+		identity.ContainerName = c.Metadata.Name
+		identity.Attempt = c.Metadata.Attempt
+	}
+	return identity
+}
+
+// identityFromStatus builds the identity fields of an event received from the GetContainerEvents
+// stream, from the sandbox status and, when present, the container status it carries.
+//
+// Parameters:
+//   - containerId string: the ID of the container.
+//   - sandbox *cri.PodSandboxStatus: the status of the pod sandbox (may be nil).
+//   - containerStatus *cri.ContainerStatus: the status of the container (may be nil).
+//
+// Returns:
+//   - *gen.ContainerEvent: an event with only the container and pod identity set.
+func identityFromStatus(
+	containerId string,
+	sandbox *cri.PodSandboxStatus,
+	containerStatus *cri.ContainerStatus,
+) *gen.ContainerEvent {
+	identity := &gen.ContainerEvent{ContainerId: containerId}
+
+	if containerStatus != nil {
+		identity.PodUid = containerStatus.Labels[podUidLabel]
+		identity.PodName = containerStatus.Labels[podNameLabel]
+		identity.PodNamespace = containerStatus.Labels[podNamespaceLabel]
+		if containerStatus.Metadata != nil {
+			identity.ContainerName = containerStatus.Metadata.Name
+			identity.Attempt = containerStatus.Metadata.Attempt
+		}
+	}
+
+	if sandbox != nil {
+		identity.PodSandboxId = sandbox.Id
+		if sandbox.Metadata != nil {
+			identity.PodUid = sandbox.Metadata.Uid
+			identity.PodName = sandbox.Metadata.Name
+			identity.PodNamespace = sandbox.Metadata.Namespace
+		}
+	}
+
+	return identity
+}
+
+// newEvent creates an event of the given type from the identity of a container.
+//
+// Parameters:
+//   - identity *gen.ContainerEvent: the container and pod identity, copied into the event.
+//   - eventType string: the type of the event (e.g., TypeStarted).
+//   - timestamp int64: the time of the event in nanoseconds since epoch.
+//   - source string: SourceCriEvents or SourcePolling.
+//
+// Returns:
+//   - *gen.ContainerEvent: the event.
+func newEvent(
+	identity *gen.ContainerEvent,
+	eventType string,
+	timestamp int64,
+	source string,
+) *gen.ContainerEvent {
+	return &gen.ContainerEvent{
+		Type:          eventType,
+		Timestamp:     timestamp,
+		Source:        source,
+		ContainerId:   identity.ContainerId,
+		ContainerName: identity.ContainerName,
+		Attempt:       identity.Attempt,
+		PodSandboxId:  identity.PodSandboxId,
+		PodUid:        identity.PodUid,
+		PodName:       identity.PodName,
+		PodNamespace:  identity.PodNamespace,
+	}
+}
+
+// setExit sets the exit code and reason of a "stopped" event from the status of the container.
+//
+// Parameters:
+//   - event *gen.ContainerEvent: the event to update.
+//   - containerStatus *cri.ContainerStatus: the status of the exited container.
+func setExit(
+	event *gen.ContainerEvent,
+	containerStatus *cri.ContainerStatus,
+) {
+	event.ExitCode = wrapperspb.Int32(containerStatus.ExitCode)
+	event.Reason = containerStatus.Reason
+}
@@ -0,0 +1,467 @@
+// Package lifecycle reports container lifecycle events (created, started, stopped, deleted) from the
+// CRI GetContainerEvents stream, falling back to polling the container list on runtimes without it.
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// Container event types.
+const (
+	TypeCreated = "created"
+	TypeStarted = "started"
+	TypeStopped = "stopped"
+	TypeDeleted = "deleted"
+)
+
+// Container event sources.
+const (
+	SourceCriEvents = "cri_events"
+	SourcePolling   = "polling"
+)
+
+const (
+	// maxPendingEvents bounds the events kept between two snapshots; the oldest are dropped beyond it.
+	maxPendingEvents = 1024
+
+	// minBackoff and maxBackoff bound the delay before reconnecting an interrupted event stream.
+	minBackoff = time.Second
+	maxBackoff = 30 * time.Second
+
+	// unimplementedRetry is how long the container list is polled before subscribing again on a runtime
+	// that does not implement GetContainerEvents, in case it was upgraded or replaced.
+	unimplementedRetry = 10 * time.Minute
+)
+
+// knownContainer is the last state of a container seen by the stream or by polling, with its
+// identity so that a deletion can be reported with it.
+type knownContainer struct {
+	state    cri.ContainerState
+	identity *gen.ContainerEvent
+}
+
+// Watcher subscribes to the CRI GetContainerEvents stream, as kubelet's evented PLEG does, and
+// accumulates the lifecycle events of the containers until the next snapshot drains them.
+//
+// The stream is reconnected with an exponential backoff when it is interrupted (e.g., runtime
+// restart). While it is down, or when the runtime does not implement it, the container list is
+// polled and the state transitions between two listings are reported instead. Stream and polling
+// share the last known state of each container, so switching from one to the other neither repeats
+// nor loses transitions.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type Watcher struct {
+	mu           sync.Mutex
+	events       []*gen.ContainerEvent
+	dropped      uint64
+	known        map[string]knownContainer
+	primed       bool
+	pollInterval time.Duration
+	logger       *zap.Logger
+}
+
+// NewWatcher creates a Watcher. Events are only collected once Run is started.
+//
+// Parameters:
+//   - pollInterval time.Duration: interval of the container list polling used while the stream is
+//     unavailable, 0 to disable it.
+//   - logger *zap.Logger: logger for the stream state changes.
+//
+// Returns:
+//   - *Watcher: the watcher.
+func NewWatcher(
+	pollInterval time.Duration,
+	logger *zap.Logger,
+) *Watcher {
+	return &Watcher{
+		known:        make(map[string]knownContainer),
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Run watches the container lifecycle events until ctx is cancelled.
+//
+// It blocks and is meant to be run in its own goroutine. The CRI client is obtained from client
+// on every (re)connection, so that a runtime connected later (node-only mode) or reconnected is
+// picked up; nothing is collected while it returns nil.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation stops the watcher.
+//   - client func() cri.RuntimeServiceClient: returns the current CRI client, or nil while none is connected.
+func (w *Watcher) Run(
+	ctx context.Context,
+	client func() cri.RuntimeServiceClient,
+) {
+	backoff := minBackoff
+	unimplementedLogged := false
+
+	for ctx.Err() == nil {
+		retryAt := time.Now().Add(minBackoff)
+
+		if runtimeClient := client(); runtimeClient != nil {
+			start := time.Now()
+			err := w.stream(ctx, runtimeClient)
+			if ctx.Err() != nil {
+				return
+			}
+
+			if status.Code(err) == codes.Unimplemented {
+				if !unimplementedLogged {
+					w.logger.Info("runtime does not implement GetContainerEvents, polling the container list",
+						zap.Duration("poll_interval", w.pollInterval))
+					unimplementedLogged = true
+				}
+				retryAt = time.Now().Add(unimplementedRetry)
+			} else {
+				// A stream that lived longer than the maximum backoff was healthy: start over.
+				if time.Since(start) > maxBackoff {
+					backoff = minBackoff
+				}
+				w.logger.Warn("container events stream interrupted, polling the container list until reconnected",
+					zap.Duration("retry_in", backoff), zap.Error(err))
+				retryAt = time.Now().Add(backoff)
+				backoff = min(backoff*2, maxBackoff)
+			}
+		}
+
+		w.pollUntil(ctx, client, retryAt)
+	}
+}
+
+// Drain returns the events received since the previous call and clears them.
+//
+// Returns:
+//   - []*gen.ContainerEvent: the events, oldest first, or nil if none.
+func (w *Watcher) Drain() []*gen.ContainerEvent {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.dropped > 0 {
+		w.logger.Warn("container events dropped, too many events between two snapshots",
+			zap.Uint64("dropped", w.dropped), zap.Int("max_pending_events", maxPendingEvents))
+		w.dropped = 0
+	}
+
+	events := w.events
+	w.events = nil
+	return events
+}
+
+// stream subscribes to GetContainerEvents and records the received events until the stream fails.
+//
+// The container list is polled once after subscribing, before receiving, to report the
+// transitions missed while no stream was open (or to record the baseline on the first
+// subscription). Stream events for transitions this poll already reported are skipped.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation closes the stream.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client.
+//
+// Returns:
+//   - error: the error that ended the stream (codes.Unimplemented if the runtime does not implement it).
+func (w *Watcher) stream(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := runtimeClient.GetContainerEvents(streamCtx, &cri.GetEventsRequest{})
+	if err != nil {
+		return err
+	}
+
+	w.poll(ctx, runtimeClient)
+
+	connected := false
+	for {
+		resp, err := events.Recv()
+		if err != nil {
+			return err
+		}
+		if !connected {
+			w.logger.Info("receiving container events from the runtime")
+			connected = true
+		}
+		w.handleStreamEvent(resp)
+	}
+}
+
+// pollUntil polls the container list every pollInterval until the deadline or the cancellation of ctx.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation.
+//   - client func() cri.RuntimeServiceClient: returns the current CRI client, or nil while none is connected.
+//   - deadline time.Time: when to stop polling and return.
+func (w *Watcher) pollUntil(
+	ctx context.Context,
+	client func() cri.RuntimeServiceClient,
+	deadline time.Time,
+) {
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	if w.pollInterval <= 0 {
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		return
+	}
+
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if runtimeClient := client(); runtimeClient != nil {
+			w.poll(ctx, runtimeClient)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleStreamEvent records an event received from the GetContainerEvents stream and updates
+// the known state of its container. Events of pod sandboxes, which some runtimes send on the
+// same stream with the sandbox ID as container ID, are ignored, as are events for transitions
+// already reported by polling (the container is known in that state or a later one, or is no
+// longer known for a deletion).
+//
+// Parameters:
+//   - resp *cri.ContainerEventResponse: the event received from the runtime.
+func (w *Watcher) handleStreamEvent(
+	resp *cri.ContainerEventResponse,
+) {
+	sandbox := resp.PodSandboxStatus
+	if sandbox != nil && sandbox.Id == resp.ContainerId {
+		return
+	}
+
+	var containerStatus *cri.ContainerStatus
+	for _, s := range resp.ContainersStatuses {
+		if s != nil && s.Id == resp.ContainerId {
+			containerStatus = s
+			break
+		}
+	}
+
+	var eventType string
+	var state cri.ContainerState
+	switch resp.ContainerEventType {
+	case cri.ContainerEventType_CONTAINER_CREATED_EVENT:
+		eventType, state = TypeCreated, cri.ContainerState_CONTAINER_CREATED
+	case cri.ContainerEventType_CONTAINER_STARTED_EVENT:
+		eventType, state = TypeStarted, cri.ContainerState_CONTAINER_RUNNING
+	case cri.ContainerEventType_CONTAINER_STOPPED_EVENT:
+		eventType, state = TypeStopped, cri.ContainerState_CONTAINER_EXITED
+	case cri.ContainerEventType_CONTAINER_DELETED_EVENT:
+		eventType = TypeDeleted
+	default:
+		return
+	}
+
+	timestamp := resp.CreatedAt
+	if timestamp == 0 {
+		timestamp = time.Now().UnixNano()
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	previous, ok := w.known[resp.ContainerId]
+	if eventType == TypeDeleted && !ok && w.primed {
+		return
+	}
+	if eventType != TypeDeleted && ok && stateRank(previous.state) >= stateRank(state) {
+		return
+	}
+
+	identity := identityFromStatus(resp.ContainerId, sandbox, containerStatus)
+	if ok && containerStatus == nil {
+		identity = previous.identity
+	}
+
+	event := newEvent(identity, eventType, timestamp, SourceCriEvents)
+	if eventType == TypeStopped && containerStatus != nil {
+		setExit(event, containerStatus)
+	}
+	w.appendLocked(event)
+
+	if eventType == TypeDeleted {
+		delete(w.known, resp.ContainerId)
+	} else {
+		w.known[resp.ContainerId] = knownContainer{state: state, identity: identity}
+	}
+}
+
+// poll lists the containers and records the transitions since the previous listing (or since
+// the last stream event). The first successful listing only records the baseline.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the CRI calls.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client.
+func (w *Watcher) poll(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+) {
+	resp, err := runtimeClient.ListContainers(ctx, &cri.ListContainersRequest{})
+	if err != nil {
+		w.logger.Debug("failed to list containers for lifecycle events", zap.Error(err))
+		return
+	}
+
+	w.mu.Lock()
+	primed := w.primed
+	known := make(map[string]knownContainer, len(w.known))
+	for id, k := range w.known {
+		known[id] = k
+	}
+	w.mu.Unlock()
+
+	var events []*gen.ContainerEvent
+	listed := make(map[string]knownContainer, len(resp.Containers))
+
+	for _, c := range resp.Containers {
+		identity := identityFromContainer(c)
+		previous, ok := known[c.Id]
+
+		// An unknown state is transient (e.g., runtime busy): keep the last known one so that
+		// the next listing reports the transition from it.
+		state := c.State
+		if ok && state == cri.ContainerState_CONTAINER_UNKNOWN {
+			state = previous.state
+		}
+		listed[c.Id] = knownContainer{state: state, identity: identity}
+
+		if !primed || (ok && (previous.state == c.State || c.State == cri.ContainerState_CONTAINER_UNKNOWN)) {
+			continue
+		}
+		events = append(events, w.transitions(ctx, runtimeClient, c, identity, previous.state, ok)...)
+	}
+
+	now := time.Now().UnixNano()
+	if primed {
+		for id, previous := range known {
+			if _, ok := listed[id]; !ok {
+				events = append(events, newEvent(previous.identity, TypeDeleted, now, SourcePolling))
+			}
+		}
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.known = listed
+	w.primed = true
+	for _, event := range events {
+		w.appendLocked(event)
+	}
+}
+
+// transitions returns the events leading a container from its previous state to its current one,
+// in order: a container seen for the first time already exited is reported as created, started
+// and stopped. The start and finish times and the exit details are read from ContainerStatus.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the ContainerStatus RPC.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client.
+//   - c *cri.Container: the container as listed.
+//   - identity *gen.ContainerEvent: the identity of the container.
+//   - previous cri.ContainerState: the previous state of the container.
+//   - known bool: false if the container was not known, previous is then ignored.
+//
+// Returns:
+//   - []*gen.ContainerEvent: the events, oldest first.
+func (w *Watcher) transitions(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+	c *cri.Container,
+	identity *gen.ContainerEvent,
+	previous cri.ContainerState,
+	known bool,
+) []*gen.ContainerEvent {
+	from := -1
+	if known {
+		from = max(stateRank(previous), 0)
+	}
+	to := stateRank(c.State)
+
+	var events []*gen.ContainerEvent
+	if from < 0 && to >= 0 {
+		events = append(events, newEvent(identity, TypeCreated, c.CreatedAt, SourcePolling))
+	}
+	if to <= max(from, 0) {
+		return events
+	}
+
+	now := time.Now().UnixNano()
+	var containerStatus *cri.ContainerStatus
+	if resp, err := runtimeClient.ContainerStatus(ctx, &cri.ContainerStatusRequest{ContainerId: c.Id}); err == nil {
+		containerStatus = resp.Status
+	}
+
+	// A container that failed to start goes from created to exited without a start time.
+	if from < 1 && (containerStatus == nil || containerStatus.StartedAt > 0) {
+		startedAt := now
+		if containerStatus != nil {
+			startedAt = containerStatus.StartedAt
+		}
+		events = append(events, newEvent(identity, TypeStarted, startedAt, SourcePolling))
+	}
+
+	if to == 2 {
+		event := newEvent(identity, TypeStopped, now, SourcePolling)
+		if containerStatus != nil {
+			if containerStatus.FinishedAt > 0 {
+				event.Timestamp = containerStatus.FinishedAt
+			}
+			setExit(event, containerStatus)
+		}
+		events = append(events, event)
+	}
+
+	return events
+}
+
+// appendLocked appends an event, dropping the oldest one beyond maxPendingEvents. Must be called with w.mu held.
+func (w *Watcher) appendLocked(
+	event *gen.ContainerEvent,
+) {
+	if len(w.events) >= maxPendingEvents {
+		w.events = w.events[1:]
+		w.dropped++
+	}
+	w.events = append(w.events, event)
+}
+
+// stateRank orders the container states along the lifecycle: 0 created, 1 running, 2 exited,
+// and -1 for an unknown state.
+func stateRank(
+	state cri.ContainerState,
+) int {
+	switch state {
+	case cri.ContainerState_CONTAINER_CREATED:
+		return 0
+	case cri.ContainerState_CONTAINER_RUNNING:
+		return 1
+	case cri.ContainerState_CONTAINER_EXITED:
+		return 2
+	default:
+		return -1
+	}
+}
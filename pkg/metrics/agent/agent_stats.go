@@ -0,0 +1,155 @@
+package agent
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/buildinfo"
+	"github.com/kubensage/kubensage-agent/pkg/health"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"golang.org/x/sys/unix"
+)
+
+// selfStatmPath is the memory status of the agent process. It is read from the /proc mounted in
+// the agent's own PID namespace rather than through HOST_PROC: the agent PID is meaningless in the
+// host /proc without hostPID, and may even designate another host process (e.g., PID 1).
+const selfStatmPath = "/proc/self/statm"
+
+// SelfSampler measures the resource usage of the agent process itself.
+//
+// CPU usage is computed from getrusage between two consecutive calls to BuildAgentStats, i.e.
+// over one collection interval.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type SelfSampler struct {
+	mu      sync.Mutex
+	cpuTime time.Duration
+	at      time.Time
+}
+
+// NewSelfSampler creates a SelfSampler for the current process and primes its CPU counters,
+// so that the first snapshot already reports CPU usage since agent start.
+//
+// Returns:
+//   - *SelfSampler: the sampler. If the CPU time cannot be read, the first snapshot reports
+//     the average CPU usage since the sampler creation.
+func NewSelfSampler() *SelfSampler {
+	s := &SelfSampler{at: time.Now()}
+	if cpuTime, err := selfCPUTime(); err == nil {
+		s.cpuTime = cpuTime
+	}
+	return s
+}
+
+// cpuPercent returns the CPU usage of the agent since the previous call, in percent of one core.
+//
+// Returns:
+//   - float64: the CPU usage (above 100 when more than one core is used).
+//   - error: non-nil if the CPU time of the process cannot be read.
+func (s *SelfSampler) cpuPercent() (float64, error) {
+	cpuTime, err := selfCPUTime()
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var percent float64
+	if elapsed := now.Sub(s.at); elapsed > 0 && cpuTime >= s.cpuTime {
+		percent = float64(cpuTime-s.cpuTime) / float64(elapsed) * 100
+	}
+	s.cpuTime, s.at = cpuTime, now
+
+	return percent, nil
+}
+
+// selfCPUTime returns the user and system CPU time consumed by the agent process.
+func selfCPUTime() (time.Duration, error) {
+	var usage unix.Rusage
+	if err := unix.Getrusage(unix.RUSAGE_SELF, &usage); err != nil {
+		return 0, err
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano()), nil
+}
+
+// selfRSS returns the resident set size of the agent process, from the second field of
+// /proc/self/statm (in pages).
+func selfRSS() (uint64, error) {
+	data, err := os.ReadFile(selfStatmPath)
+	if err != nil {
+		return 0, err
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) < 2 {
+		return 0, fmt.Errorf("unexpected format of %s", selfStatmPath)
+	}
+	pages, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return pages * uint64(os.Getpagesize()), nil
+}
+
+// BuildAgentStats assembles the self-telemetry of the agent for one collection cycle.
+//
+// Parameters:
+//   - sampler *SelfSampler: sampler of the agent process resource usage.
+//   - counters health.Counters: agent counters (buffer fill, drops, send failures, reconnects).
+//   - collectionDuration time.Duration: total duration of the collection cycle.
+//   - probeDurations map[string]time.Duration: duration of each probe of the collection cycle.
+//   - slowestContainerID string: ID of the container whose metrics took the longest to build.
+//   - slowestContainerDuration time.Duration: time taken to build the slowest container metrics.
+//   - collectionErrors int: number of errors encountered during the collection cycle.
+//
+// Returns:
+//   - *gen.AgentStats: the self-telemetry message to embed in the snapshot.
+func BuildAgentStats(
+	sampler *SelfSampler,
+	counters health.Counters,
+	collectionDuration time.Duration,
+	probeDurations map[string]time.Duration,
+	slowestContainerID string,
+	slowestContainerDuration time.Duration,
+	collectionErrors int,
+) *gen.AgentStats {
+	probes := make(map[string]int64, len(probeDurations))
+	for name, d := range probeDurations {
+		probes[name] = d.Nanoseconds()
+	}
+
+	stats := &gen.AgentStats{
+		Version:                    buildinfo.Version,
+		GoVersion:                  runtime.Version(),
+		CollectionDurationNs:       collectionDuration.Nanoseconds(),
+		ProbeDurationsNs:           probes,
+		SlowestContainerId:         slowestContainerID,
+		SlowestContainerDurationNs: slowestContainerDuration.Nanoseconds(),
+		CollectionErrors:           uint32(collectionErrors),
+		BufferLen:                  uint32(counters.Buffer.Len),
+		BufferBytes:                uint64(counters.Buffer.Bytes),
+		BufferMaxBytes:             uint64(counters.Buffer.MaxBytes),
+		DroppedSnapshots:           counters.Buffer.DroppedSnapshots,
+		StrippedSnapshots:          counters.Buffer.StrippedSnapshots,
+		SendFailures:               counters.SendFailures,
+		Reconnects:                 counters.Reconnects,
+		Goroutines:                 uint32(runtime.NumGoroutine()),
+	}
+
+	if rss, err := selfRSS(); err == nil {
+		stats.RssBytes = rss
+	}
+	if sampler != nil {
+		if cpuPercent, err := sampler.cpuPercent(); err == nil {
+			stats.CpuPercent = cpuPercent
+		}
+	}
+
+	return stats
+}
@@ -2,16 +2,19 @@ package metrics
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"sync"
 	"time"
 
-	"github.com/kubensage/go-common/datastructure"
 	gogo "github.com/kubensage/go-common/go"
+	"github.com/kubensage/kubensage-agent/pkg/buffer"
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
 	"github.com/kubensage/kubensage-agent/pkg/cli"
+	"github.com/kubensage/kubensage-agent/pkg/metrics/agent"
 	"github.com/kubensage/kubensage-agent/pkg/metrics/container"
 	"github.com/kubensage/kubensage-agent/pkg/metrics/node"
 	"github.com/kubensage/kubensage-agent/pkg/metrics/pod"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"go.uber.org/zap"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
@@ -20,7 +23,8 @@ import (
 // CollectOnce performs a single metrics collection cycle.
 //
 // It retrieves metrics from the node, containers, and pods by calling the internal `collect`
-// function. The gathered metrics are then pushed into the provided ring buffer for later transmission.
+// function. The gathered metrics are then pushed into the provided snapshot buffer for later transmission.
+// If the buffer exceeds its byte budget, its drop policy decides what is discarded and the loss is counted.
 //
 // This function is typically invoked periodically by the main loop.
 //
@@ -29,8 +33,11 @@ import (
 //     Context for managing timeouts or cancellation of the metric collection process.
 //   - runtimeClient cri.RuntimeServiceClient:
 //     CRI client used to query the container runtime for pods, containers, and stats.
-//   - buffer *datastructure.RingBuffer[*gen.Metrics]:
-//     A ring buffer where the collected *gen.Metrics data is stored.
+//     May be nil when the agent runs in node-only mode, in which case only node metrics are collected.
+//   - buffer *buffer.SnapshotBuffer:
+//     A byte-bounded buffer where the collected *gen.Metrics data is stored.
+//   - state *CollectorState:
+//     Long-lived state shared by collection cycles (health counters, samplers).
 //   - agentCfg *cli.AgentConfig:
 //     Agent configuration, including the number of top memory-consuming processes to collect.
 //   - logger *zap.Logger:
@@ -43,14 +50,15 @@ import (
 func CollectOnce(
 	ctx context.Context,
 	runtimeClient cri.RuntimeServiceClient,
-	buffer *datastructure.RingBuffer[*gen.Metrics],
+	buffer *buffer.SnapshotBuffer,
+	state *CollectorState,
 	agentCfg *cli.AgentConfig,
 	logger *zap.Logger,
 ) []error {
 	start := time.Now()
 	logger.Info("collect start", zap.Int("topN", agentCfg.TopN))
 
-	metricsData, errs := collect(ctx, runtimeClient, logger, agentCfg.TopN)
+	metricsData, errs := collect(ctx, runtimeClient, state, logger, agentCfg)
 
 	// Se errori, log ERROR + breve riepilogo INFO
 	if errs != nil && len(errs) > 0 {
@@ -78,7 +86,20 @@ func CollectOnce(
 		}
 	}
 
+	droppedBefore := buffer.Stats().DroppedSnapshots
 	buffer.Add(metricsData)
+	if stats := buffer.Stats(); stats.DroppedSnapshots > droppedBefore {
+		logger.Warn("buffer over budget, snapshots dropped",
+			zap.Uint64("dropped", stats.DroppedSnapshots-droppedBefore),
+			zap.Int("buffer_bytes", stats.Bytes),
+			zap.Int("buffer_max_bytes", stats.MaxBytes),
+		)
+	} else if stats.Bytes > stats.MaxBytes {
+		logger.Warn("snapshot larger than the buffer budget, kept alone",
+			zap.Int("buffer_bytes", stats.Bytes),
+			zap.Int("buffer_max_bytes", stats.MaxBytes),
+		)
+	}
 
 	logger.Info("collect enqueued",
 		zap.Int64("timestamp", metricsData.Timestamp),
@@ -101,19 +122,27 @@ func CollectOnce(
 //   - Lists running pods
 //   - Lists running containers
 //   - Fetches container stats
+//   - Scans the kubelet cgroups and reads the pressure (PSI) of the QoS, pod and container
+//     cgroups and the cgroup statistics of the containers (CPU throttling, memory breakdown, ...)
+//
+// The CRI calls are skipped when runtimeClient is nil (node-only mode); the returned
+// metrics then carry node metrics only.
 //
 // Then it aggregates container metrics per pod, builds corresponding
-// *gen.PodMetrics, and wraps everything into a *gen.Metrics structure.
+// *gen.PodMetrics, and wraps everything into a *gen.Metrics structure together
+// with the agent self-telemetry (durations, buffer fill, send counters, own usage).
 //
 // Parameters:
 //   - ctx context.Context:
 //     Context for managing cancellation and timeouts.
 //   - runtimeClient cri.RuntimeServiceClient:
-//     CRI client interface for interacting with the container runtime.
+//     CRI client interface for interacting with the container runtime, or nil in node-only mode.
+//   - state *CollectorState:
+//     Long-lived state shared by collection cycles (health counters, samplers, kernel events watcher).
 //   - logger *zap.Logger:
 //     Logger instance used for debugging and error reporting.
-//   - topN int:
-//     Number of top memory-consuming processes to include in node metrics.
+//   - agentCfg *cli.AgentConfig:
+//     Agent configuration (number of top processes, filesystem selection, ...) used by the node collectors.
 //
 // Returns:
 //   - *gen.Metrics:
@@ -123,8 +152,9 @@ func CollectOnce(
 func collect(
 	ctx context.Context,
 	runtimeClient cri.RuntimeServiceClient,
+	state *CollectorState,
 	logger *zap.Logger,
-	topN int,
+	agentCfg *cli.AgentConfig,
 ) (*gen.Metrics, []error) {
 	start := time.Now()
 	timestamp := time.Now().Unix()
@@ -147,10 +177,16 @@ func collect(
 	var listPodsDuration time.Duration
 	var listContainerDuration time.Duration
 	var listContainersStatsDuration time.Duration
+	var listPodSandboxStatsDuration time.Duration
+	var scanKubepodsDuration time.Duration
+	var cgroupPressureDuration time.Duration
+	var readCgroupMetricsDuration time.Duration
 
 	// Durations (post-processing)
 	var buildContainerMetricsTotalDuration time.Duration
 	var buildPodMetricsTotalDuration time.Duration
+	var containerResourcesTotalDuration time.Duration
+	var containerExitTotalDuration time.Duration
 
 	// Per-debug: container più lento
 	var slowestContainerID string
@@ -158,10 +194,11 @@ func collect(
 
 	// ===== parallel fetch =====
 	var nodeMetrics *gen.NodeMetrics
+	var nodeProbeDurations map[string]time.Duration
 	gogo.SafeGo(&wg, func() {
 		var subErrs []error
 		var d time.Duration
-		nodeMetrics, subErrs, d = node.BuildNodeMetrics(ctx, 0*time.Second, logger, topN)
+		nodeMetrics, nodeProbeDurations, subErrs, d = node.BuildNodeMetrics(ctx, state.Node, logger, agentCfg)
 		buildNodeMetricsDuration = d
 		if subErrs != nil {
 			for _, e := range subErrs {
@@ -170,38 +207,82 @@ func collect(
 		}
 	})
 
-	var pods []*cri.PodSandbox
+	var qosPressure, podsPressure, containersPressure map[string]*gen.CgroupPressure
+	var containersCgroupMetrics map[string]*gen.CgroupMetrics
+	var kubepods *cgroup.Kubepods
 	gogo.SafeGo(&wg, func() {
+		scanStart := time.Now()
 		var err error
-		var d time.Duration
-		pods, err, d = pod.ListPods(ctx, runtimeClient, true)
-		listPodsDuration = d
-		addErr(err)
-	})
+		kubepods, err = cgroup.ScanKubepods("memory")
+		scanKubepodsDuration = time.Since(scanStart)
+		if err != nil {
+			addErr(utils.NewCollectorError("scan_kubepods", err))
+			return
+		}
 
-	var containers []*cri.Container
-	gogo.SafeGo(&wg, func() {
-		var err error
-		var d time.Duration
-		containers, err, d = container.ListContainers(ctx, runtimeClient)
-		listContainerDuration = d
-		addErr(err)
+		qosPressure, podsPressure, containersPressure, cgroupPressureDuration = state.Node.Psi.SampleKubepods(kubepods)
+		containersCgroupMetrics, readCgroupMetricsDuration = container.ReadCgroupMetrics(kubepods)
 	})
 
+	var pods []*cri.PodSandbox
+	var containers []*cri.Container
 	var containersStats []*cri.ContainerStats
-	gogo.SafeGo(&wg, func() {
-		var err error
-		var d time.Duration
-		containersStats, err, d = container.ListContainersStats(ctx, runtimeClient)
-		listContainersStatsDuration = d
-		addErr(err)
-	})
+	var sandboxesStats []*cri.PodSandboxStats
+	var containersListed, containersStatsListed, podsListed, sandboxesStatsListed bool
+
+	if runtimeClient != nil {
+		gogo.SafeGo(&wg, func() {
+			var err error
+			var d time.Duration
+			pods, err, d = pod.ListPods(ctx, runtimeClient, agentCfg.PodStateFilter == cli.PodStateReady)
+			listPodsDuration = d
+			podsListed = err == nil
+			addErr(utils.NewCollectorError("list_pods", err))
+		})
+
+		gogo.SafeGo(&wg, func() {
+			var err error
+			var d time.Duration
+			containers, err, d = container.ListContainers(ctx, runtimeClient)
+			listContainerDuration = d
+			containersListed = err == nil
+			addErr(utils.NewCollectorError("list_containers", err))
+		})
+
+		gogo.SafeGo(&wg, func() {
+			var err error
+			var d time.Duration
+			containersStats, err, d = container.ListContainersStats(ctx, runtimeClient)
+			listContainersStatsDuration = d
+			containersStatsListed = err == nil
+			addErr(utils.NewCollectorError("list_containers_stats", err))
+		})
+
+		gogo.SafeGo(&wg, func() {
+			var err error
+			var d time.Duration
+			sandboxesStats, err, d = pod.ListPodSandboxStats(ctx, runtimeClient)
+			listPodSandboxStatsDuration = d
+			sandboxesStatsListed = err == nil
+			// Runtimes without ListPodSandboxStats fall back to the sum of the container metrics.
+			if !errors.Is(err, pod.ErrSandboxStatsUnimplemented) {
+				addErr(utils.NewCollectorError("list_pod_sandbox_stats", err))
+			}
+		})
+	}
 
 	wg.Wait()
 
 	// ===== correlate & build =====
 	var podsMetrics []*gen.PodMetrics
 
+	sandboxStatsMap := make(map[string]*cri.PodSandboxStats, len(sandboxesStats))
+	for _, s := range sandboxesStats {
+		if s.Attributes != nil {
+			sandboxStatsMap[s.Attributes.Id] = s
+		}
+	}
+
 	containerMap := make(map[string][]*cri.Container)
 	for _, c := range containers {
 		containerMap[c.PodSandboxId] = append(containerMap[c.PodSandboxId], c)
@@ -212,11 +293,37 @@ func collect(
 		cs := containerMap[p.Id]
 
 		for _, c := range cs {
-			metrics, err, d := container.BuildContainerMetrics(c, containersStats, logger)
-			if err != nil {
-				addErr(fmt.Errorf("failed to get container stats for container %s: %v", c.Id, err))
-				continue
+			metrics, d := container.BuildContainerMetrics(c, containersStats, state.ContainerCpu,
+				&agentCfg.LabelFilter, &agentCfg.AnnotationFilter, logger)
+			metrics.Pressure = containersPressure[c.Id]
+			metrics.CgroupMetrics = containersCgroupMetrics[c.Id]
+
+			if c.State == cri.ContainerState_CONTAINER_EXITED {
+				exitStart := time.Now()
+				exit, err := state.ContainerExits.Get(ctx, runtimeClient, c)
+				if err != nil {
+					addErr(utils.NewCollectorError("container_exit", err))
+				} else {
+					metrics.Exit = exit
+				}
+				containerExitTotalDuration += time.Since(exitStart)
+			}
+
+			if c.State == cri.ContainerState_CONTAINER_RUNNING {
+				resourcesStart := time.Now()
+				var cgroupPath string
+				if kubepods != nil {
+					cgroupPath = kubepods.Containers[c.Id]
+				}
+				resources, err := state.ContainerResources.Get(ctx, runtimeClient, c.Id, cgroupPath)
+				if err != nil {
+					addErr(utils.NewCollectorError("container_resources", err))
+				} else {
+					container.ApplyResources(metrics, resources)
+				}
+				containerResourcesTotalDuration += time.Since(resourcesStart)
 			}
+
 			containersMetrics = append(containersMetrics, metrics)
 
 			buildContainerMetricsTotalDuration += d
@@ -227,12 +334,33 @@ func collect(
 		}
 
 		podStart := time.Now()
-		podMetric, _ := pod.BuildPodMetrics(p, containersMetrics)
+		podMetric, _ := pod.BuildPodMetrics(p, containersMetrics, &agentCfg.LabelFilter, &agentCfg.AnnotationFilter)
+		podMetric.Pressure = podsPressure[p.Metadata.Uid]
+		if sandboxStats, ok := sandboxStatsMap[p.Id]; ok {
+			pod.ApplySandboxStats(podMetric, p, sandboxStats, state.PodCpu)
+		} else {
+			pod.ApplyContainersSum(podMetric)
+		}
 		buildPodMetricsTotalDuration += time.Since(podStart)
 
 		podsMetrics = append(podsMetrics, podMetric)
 	}
 
+	if nodeMetrics != nil {
+		nodeMetrics.QosPressure = qosPressure
+	}
+
+	// Drop the state of deleted containers, unless a failed listing made every container look deleted.
+	// Containers are only visited through their pod, so the pods must have been listed too.
+	if podsListed && containersListed && containersStatsListed {
+		state.ContainerCpu.Sweep()
+		state.ContainerResources.Sweep()
+		state.ContainerExits.Sweep()
+	}
+	if podsListed && sandboxesStatsListed {
+		state.PodCpu.Sweep()
+	}
+
 	totalDuration := time.Since(start)
 
 	logger.Debug("collect summary",
@@ -240,14 +368,21 @@ func collect(
 		zap.Int("pods_count", len(pods)),
 		zap.Int("containers_count", len(containers)),
 		zap.Int("containers_stats_count", len(containersStats)),
+		zap.Int("pod_sandbox_stats_count", len(sandboxesStats)),
 
 		zap.Duration("build_node_metrics", buildNodeMetricsDuration),
 		zap.Duration("list_pods", listPodsDuration),
 		zap.Duration("list_containers", listContainerDuration),
 		zap.Duration("list_containers_stats", listContainersStatsDuration),
+		zap.Duration("list_pod_sandbox_stats", listPodSandboxStatsDuration),
+		zap.Duration("scan_kubepods", scanKubepodsDuration),
+		zap.Duration("cgroup_pressure", cgroupPressureDuration),
+		zap.Duration("read_cgroup_metrics", readCgroupMetricsDuration),
 
 		zap.Duration("build_container_metrics_total", buildContainerMetricsTotalDuration),
 		zap.Duration("build_pod_metrics_total", buildPodMetricsTotalDuration),
+		zap.Duration("container_resources_total", containerResourcesTotalDuration),
+		zap.Duration("container_exit_total", containerExitTotalDuration),
 
 		zap.String("slowest_container_id", slowestContainerID),
 		zap.Duration("slowest_container_duration", slowestContainerDuration),
@@ -268,10 +403,41 @@ func collect(
 		logger.Info("collect completed successfully")
 	}
 
+	probeDurations := map[string]time.Duration{
+		"build_node_metrics":            buildNodeMetricsDuration,
+		"list_pods":                     listPodsDuration,
+		"list_containers":               listContainerDuration,
+		"list_containers_stats":         listContainersStatsDuration,
+		"list_pod_sandbox_stats":        listPodSandboxStatsDuration,
+		"scan_kubepods":                 scanKubepodsDuration,
+		"cgroup_pressure":               cgroupPressureDuration,
+		"read_cgroup_metrics":           readCgroupMetricsDuration,
+		"build_container_metrics_total": buildContainerMetricsTotalDuration,
+		"build_pod_metrics_total":       buildPodMetricsTotalDuration,
+		"container_resources_total":     containerResourcesTotalDuration,
+		"container_exit_total":          containerExitTotalDuration,
+	}
+	for name, d := range nodeProbeDurations {
+		probeDurations["node."+name] = d
+	}
+
+	agentStats := agent.BuildAgentStats(
+		state.Self,
+		state.Health.Counters(),
+		totalDuration,
+		probeDurations,
+		slowestContainerID,
+		slowestContainerDuration,
+		len(errs),
+	)
+
 	metrics := &gen.Metrics{
-		Timestamp:   timestamp,
-		NodeMetrics: nodeMetrics,
-		PodMetrics:  podsMetrics,
+		Timestamp:       timestamp,
+		NodeMetrics:     nodeMetrics,
+		PodMetrics:      podsMetrics,
+		AgentStats:      agentStats,
+		NodeEvents:      state.Events.Drain(),
+		ContainerEvents: state.Lifecycle.Drain(),
 	}
 
 	return metrics, errs
@@ -0,0 +1,39 @@
+package container
+
+import (
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// ReadCgroupMetrics reads the cgroup statistics of every container found under the kubepods cgroup.
+//
+// Unlike the CRI stats, these are read straight from the cgroup filesystem (cgroup v1 or v2) and
+// include CPU throttling, the memory breakdown and limit events, block I/O, pids and huge pages.
+// Containers whose cgroup disappears between the scan and the read are skipped.
+//
+// Parameters:
+//   - kubepods *cgroup.Kubepods: the kubelet cgroups, as returned by cgroup.ScanKubepods (may be nil).
+//
+// Returns:
+//   - map[string]*gen.CgroupMetrics: the statistics keyed by container ID, nil if kubepods is nil.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func ReadCgroupMetrics(
+	kubepods *cgroup.Kubepods,
+) (map[string]*gen.CgroupMetrics, time.Duration) {
+	start := time.Now()
+
+	if kubepods == nil {
+		return nil, time.Since(start)
+	}
+
+	metrics := make(map[string]*gen.CgroupMetrics, len(kubepods.Containers))
+	for id, path := range kubepods.Containers {
+		if stats, err := cgroup.ReadStats(path); err == nil {
+			metrics[id] = stats
+		}
+	}
+
+	return metrics, time.Since(start)
+}
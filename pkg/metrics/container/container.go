@@ -38,7 +38,7 @@ func ListContainers(
 // ListContainersStats retrieves runtime statistics for all containers managed by the CRI runtime.
 //
 // This function invokes the ListContainerStats RPC without any filters, collecting metrics
-// such as CPU, memory, I/O, and filesystem usage. If the response is empty, it returns an explicit error.
+// such as CPU, memory, I/O, and filesystem usage. An empty response (e.g., an idle node) is not an error.
 //
 // Parameters:
 //   - ctx: context.Context - used to control cancellation and timeouts for the RPC call.
@@ -46,7 +46,7 @@ func ListContainers(
 //
 // Returns:
 //   - []*cri.ContainerStats: a slice of container statistics, each representing a container's resource usage.
-//   - error: if the RPC call fails or no statistics are returned.
+//   - error: if the RPC call fails.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func ListContainersStats(
 	ctx context.Context,
@@ -60,10 +60,6 @@ func ListContainersStats(
 		return nil, fmt.Errorf("failed to list container stats: %v", err.Error()), time.Since(start)
 	}
 
-	if len(stats.Stats) == 0 {
-		return nil, fmt.Errorf("no container stats found"), time.Since(start)
-	}
-
 	return stats.Stats, nil, time.Since(start)
 }
 
@@ -3,6 +3,7 @@ package container
 import (
 	"time"
 
+	"github.com/kubensage/kubensage-agent/pkg/labels"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"go.uber.org/zap"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
@@ -13,7 +14,8 @@ import (
 //
 // The function looks up the container's stats by its ID from the provided slice of
 // *cri.ContainerStats. If a matching stats object is found, it extracts and builds
-// metrics for CPU, memory, filesystem, and swap using internal helpers.
+// metrics for CPU, memory, filesystem, and swap using internal helpers. Otherwise (e.g., an
+// exited container that the runtime no longer reports stats for) only the metadata is set.
 //
 // Parameters:
 //   - container: *cri.Container
@@ -22,21 +24,28 @@ import (
 //   - stats: []*cri.ContainerStats
 //     A slice of all available container statistics from the runtime. The function
 //     searches for the matching entry using container.Id.
+//   - cpuRates: *CpuRateTracker
+//     Per-container state used to compute the CPU usage in cores over the agent's interval.
+//   - labelFilter *labels.Filter:
+//     Selects the container labels reported and bounds their values.
+//   - annotationFilter *labels.Filter:
+//     Selects the container annotations reported and bounds their values.
 //   - logger *zap.Logger:
 //     Structured logger used for debug tracing during the operation.
 //
 // Returns:
 //
 //   - *gen.ContainerMetrics: A protobuf object that consolidates all metrics and metadata
-//     related to the container
-//   - error: Non-nil if no stats are found for the given container ID. In such cases,
-//     the returned metrics object is nil.
+//     related to the container. A missing stats entry is not an error.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func BuildContainerMetrics(
 	container *cri.Container,
 	stats []*cri.ContainerStats,
+	cpuRates *CpuRateTracker,
+	labelFilter *labels.Filter,
+	annotationFilter *labels.Filter,
 	logger *zap.Logger,
-) (*gen.ContainerMetrics, error, time.Duration) {
+) (*gen.ContainerMetrics, time.Duration) {
 	start := time.Now()
 
 	var retrieveContainerStatsByContainerIdDuration time.Duration
@@ -45,17 +54,25 @@ func BuildContainerMetrics(
 	var buildFileSystemMetricsDuration time.Duration
 	var buildSwapMetricsDuration time.Duration
 
+	var cpuMetrics *gen.CpuMetrics
+	var memoryMetrics *gen.MemoryMetrics
+	var fileSystemMetrics *gen.FileSystemMetrics
+	var swapMetrics *gen.SwapMetrics
+
 	containerStats, err, retrieveContainerStatsByContainerIdDuration := RetrieveContainerStatsByContainerId(stats, container.Id)
 
-	if err != nil {
-		return nil, err, time.Since(start)
+	if err == nil {
+		cpuMetrics, buildCpuMetricsDuration = buildCpuMetrics(container, containerStats, cpuRates)
+		memoryMetrics, buildMemoryMetricsDuration = buildMemoryMetrics(containerStats)
+		fileSystemMetrics, buildFileSystemMetricsDuration = buildFileSystemMetrics(containerStats)
+		swapMetrics, buildSwapMetricsDuration = buildSwapMetrics(containerStats)
+	} else {
+		logger.Debug("no stats for container, reporting its metadata only",
+			zap.String("container_id", container.Id),
+			zap.String("state", container.State.String()),
+		)
 	}
 
-	cpuMetrics, buildCpuMetricsDuration := buildCpuMetrics(containerStats)
-	memoryMetrics, buildMemoryMetricsDuration := buildMemoryMetrics(containerStats)
-	fileSystemMetrics, buildFileSystemMetricsDuration := buildFileSystemMetrics(containerStats)
-	swapMetrics, buildSwapMetricsDuration := buildSwapMetrics(containerStats)
-
 	containerMetrics := &gen.ContainerMetrics{
 		Id:                container.Id,
 		Name:              container.Metadata.Name,
@@ -67,6 +84,8 @@ func BuildContainerMetrics(
 		MemoryMetrics:     memoryMetrics,
 		FileSystemMetrics: fileSystemMetrics,
 		SwapMetrics:       swapMetrics,
+		Labels:            labelFilter.Apply(container.Labels),
+		Annotations:       annotationFilter.Apply(container.Annotations),
 	}
 
 	logger.Debug("container metrics durations",
@@ -79,5 +98,5 @@ func BuildContainerMetrics(
 		zap.Duration("total_duration", time.Since(start)),
 	)
 
-	return containerMetrics, nil, time.Since(start)
+	return containerMetrics, time.Since(start)
 }
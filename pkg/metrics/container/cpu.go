@@ -15,37 +15,77 @@ import (
 // and wrapping them with protobuf-compatible wrappers. If the input stats do not contain
 // CPU data, it returns an empty CpuMetrics object.
 //
+// The usage in cores is computed by the tracker from the cumulative usage over the agent's
+// own interval, rather than taken from the runtime's UsageNanoCores.
+//
 // Parameters:
+//   - container: *cri.Container
+//     The container the stats belong to, identifying its instance (Attempt, CreatedAt) for the tracker.
 //   - stats: *cri.ContainerStats
 //     The container statistics object from the CRI runtime. Expected to include CPU usage data.
+//   - tracker: *CpuRateTracker
+//     Per-container state of the cumulative usage between collection cycles.
 //
 // Returns:
 //   - *gen.CpuMetrics: A populated CpuMetrics object with:
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func buildCpuMetrics(
+	container *cri.Container,
 	stats *cri.ContainerStats,
+	tracker *CpuRateTracker,
 ) (*gen.CpuMetrics, time.Duration) {
 	start := time.Now()
 
-	if stats.Cpu == nil {
-		return &gen.CpuMetrics{}, time.Since(start)
+	var attempt uint32
+	if container.Metadata != nil {
+		attempt = container.Metadata.Attempt
+	}
+
+	return ConvertCpuUsage(container.Id, attempt, container.CreatedAt, stats.Cpu, tracker), time.Since(start)
+}
+
+// ConvertCpuUsage converts the CRI CPU usage of a container or a pod sandbox into a *gen.CpuMetrics,
+// with the usage in cores computed by the tracker.
+//
+// Parameters:
+//   - id string: the ID of the container or pod sandbox.
+//   - attempt uint32: the Attempt of the container or sandbox metadata.
+//   - createdAt int64: the creation timestamp of the container or sandbox.
+//   - cpu *cri.CpuUsage: the CPU usage reported by the runtime (may be nil).
+//   - tracker *CpuRateTracker: per-instance state of the cumulative usage between collection cycles.
+//
+// Returns:
+//   - *gen.CpuMetrics: the CPU metrics, empty if cpu is nil.
+func ConvertCpuUsage(
+	id string,
+	attempt uint32,
+	createdAt int64,
+	cpu *cri.CpuUsage,
+	tracker *CpuRateTracker,
+) *gen.CpuMetrics {
+	if cpu == nil {
+		return &gen.CpuMetrics{}
 	}
 
 	var usageCoreNanoSeconds, usageNanoCores *wrapperspb.UInt64Value
 
-	if stats.Cpu.UsageCoreNanoSeconds != nil {
-		usageCoreNanoSeconds = utils.ConvertCRIUInt64(stats.Cpu.UsageCoreNanoSeconds)
+	if cpu.UsageCoreNanoSeconds != nil {
+		usageCoreNanoSeconds = utils.ConvertCRIUInt64(cpu.UsageCoreNanoSeconds)
 	}
 
-	if stats.Cpu.UsageNanoCores != nil {
-		usageNanoCores = utils.ConvertCRIUInt64(stats.Cpu.UsageNanoCores)
+	if cpu.UsageNanoCores != nil {
+		usageNanoCores = utils.ConvertCRIUInt64(cpu.UsageNanoCores)
 	}
 
 	metrics := &gen.CpuMetrics{
-		Timestamp:            stats.Cpu.Timestamp,
+		Timestamp:            cpu.Timestamp,
 		UsageCoreNanoSeconds: usageCoreNanoSeconds,
 		UsageNanoCores:       usageNanoCores,
 	}
 
-	return metrics, time.Since(start)
+	if cores, ok := tracker.Rate(id, attempt, createdAt, cpu); ok {
+		metrics.UsageCores = wrapperspb.Double(cores)
+	}
+
+	return metrics
 }
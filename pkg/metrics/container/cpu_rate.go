@@ -0,0 +1,110 @@
+package container
+
+import (
+	"sync"
+
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// cpuSample is the cumulative CPU usage of a container at a point in time, with the identity
+// of the container instance it was read from.
+type cpuSample struct {
+	usageNanoSeconds uint64
+	timestamp        int64
+	attempt          uint32
+	createdAt        int64
+	generation       uint64
+}
+
+// CpuRateTracker computes the CPU usage rate of containers and pod sandboxes, in cores, from
+// their cumulative usage (UsageCoreNanoSeconds) over the agent's own collection interval, instead
+// of relying on UsageNanoCores, which some runtimes leave unset or compute over a different window.
+//
+// A restarted container keeps its ID with some runtimes but starts its counter over: a change
+// of Attempt or CreatedAt, or a counter going backwards, resets the baseline instead of
+// producing a bogus rate. State of containers that are no longer listed is removed by Sweep.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type CpuRateTracker struct {
+	mu         sync.Mutex
+	samples    map[string]cpuSample
+	generation uint64
+}
+
+// NewCpuRateTracker creates an empty CpuRateTracker.
+//
+// Returns:
+//   - *CpuRateTracker: the tracker.
+func NewCpuRateTracker() *CpuRateTracker {
+	return &CpuRateTracker{samples: make(map[string]cpuSample)}
+}
+
+// Rate records the current cumulative usage of a container (or pod sandbox) and computes its
+// usage rate since the previous call for the same instance.
+//
+// Parameters:
+//   - id string: the ID of the container or pod sandbox.
+//   - attempt uint32: the Attempt of the container or sandbox metadata, identifying its instance.
+//   - createdAt int64: the creation timestamp of the container or sandbox, identifying its instance.
+//   - cpu *cri.CpuUsage: the CPU stats reported by the runtime (may be nil).
+//
+// Returns:
+//   - float64: the CPU usage in cores (e.g., 0.25 for a quarter of a core).
+//   - bool: false if no rate can be computed (first sample, restarted container, missing usage).
+func (t *CpuRateTracker) Rate(
+	id string,
+	attempt uint32,
+	createdAt int64,
+	cpu *cri.CpuUsage,
+) (float64, bool) {
+	if cpu == nil || cpu.UsageCoreNanoSeconds == nil || cpu.Timestamp == 0 {
+		return 0, false
+	}
+
+	current := cpuSample{
+		usageNanoSeconds: cpu.UsageCoreNanoSeconds.Value,
+		timestamp:        cpu.Timestamp,
+		attempt:          attempt,
+		createdAt:        createdAt,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current.generation = t.generation
+	previous, ok := t.samples[id]
+
+	if ok && (previous.attempt != current.attempt || previous.createdAt != current.createdAt) {
+		ok = false
+	}
+	if ok && current.timestamp == previous.timestamp {
+		// Stats not refreshed by the runtime since the previous cycle: keep the older baseline.
+		previous.generation = t.generation
+		t.samples[id] = previous
+		return 0, false
+	}
+
+	t.samples[id] = current
+
+	if !ok || current.timestamp < previous.timestamp || current.usageNanoSeconds < previous.usageNanoSeconds {
+		return 0, false
+	}
+
+	elapsed := current.timestamp - previous.timestamp
+	return float64(current.usageNanoSeconds-previous.usageNanoSeconds) / float64(elapsed), true
+}
+
+// Sweep removes the state of containers for which Rate was not called since the previous
+// Sweep, i.e. containers that were deleted. It is meant to be called once per collection
+// cycle, after a successful container listing.
+func (t *CpuRateTracker) Sweep() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for id, sample := range t.samples {
+		if sample.generation != t.generation {
+			delete(t.samples, id)
+		}
+	}
+	t.generation++
+}
@@ -0,0 +1,127 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// restartCountAnnotation is the container annotation where kubelet records the restart count.
+const restartCountAnnotation = "io.kubernetes.container.restartCount"
+
+// exitEntry is a cached ContainerExit.
+type exitEntry struct {
+	exit       *gen.ContainerExit
+	generation uint64
+}
+
+// ExitCache fetches the exit details of exited containers from the CRI ContainerStatus and
+// caches them, as an exited container never changes: the runtime is queried once per container.
+//
+// Entries of containers that are no longer listed (removed by kubelet garbage collection) are
+// removed by Sweep.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type ExitCache struct {
+	mu         sync.Mutex
+	entries    map[string]exitEntry
+	generation uint64
+}
+
+// NewExitCache creates an empty ExitCache.
+//
+// Returns:
+//   - *ExitCache: the cache.
+func NewExitCache() *ExitCache {
+	return &ExitCache{entries: make(map[string]exitEntry)}
+}
+
+// Get returns the exit details of an exited container, from the cache or freshly fetched.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the ContainerStatus RPC.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client used to query the container status.
+//   - container *cri.Container: the exited container.
+//
+// Returns:
+//   - *gen.ContainerExit: the exit details.
+//   - error: non-nil if the ContainerStatus RPC fails or returns no status.
+func (c *ExitCache) Get(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+	container *cri.Container,
+) (*gen.ContainerExit, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[container.Id]
+	if ok {
+		entry.generation = c.generation
+		c.entries[container.Id] = entry
+		c.mu.Unlock()
+		return entry.exit, nil
+	}
+	c.mu.Unlock()
+
+	resp, err := runtimeClient.ContainerStatus(ctx, &cri.ContainerStatusRequest{ContainerId: container.Id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status of container %s: %v", container.Id, err)
+	}
+	if resp.Status == nil {
+		return nil, fmt.Errorf("no status reported for container %s", container.Id)
+	}
+
+	exit := buildContainerExit(container, resp.Status)
+
+	c.mu.Lock()
+	c.entries[container.Id] = exitEntry{exit: exit, generation: c.generation}
+	c.mu.Unlock()
+
+	return exit, nil
+}
+
+// Sweep removes the entries of containers for which Get was not called since the previous
+// Sweep, i.e. containers that were deleted. It is meant to be called once per collection
+// cycle, after a successful container listing.
+func (c *ExitCache) Sweep() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, entry := range c.entries {
+		if entry.generation != c.generation {
+			delete(c.entries, id)
+		}
+	}
+	c.generation++
+}
+
+// buildContainerExit converts the status of an exited container into a *gen.ContainerExit.
+//
+// The restart count is read from the kubelet annotation of the status (or of the listed
+// container), falling back to the attempt of the container metadata.
+func buildContainerExit(
+	container *cri.Container,
+	status *cri.ContainerStatus,
+) *gen.ContainerExit {
+	exit := &gen.ContainerExit{
+		ExitCode:   status.ExitCode,
+		Reason:     status.Reason,
+		Message:    status.Message,
+		StartedAt:  status.StartedAt,
+		FinishedAt: status.FinishedAt,
+	}
+
+	restartCount, ok := status.Annotations[restartCountAnnotation]
+	if !ok {
+		restartCount, ok = container.Annotations[restartCountAnnotation]
+	}
+	if count, err := strconv.ParseUint(restartCount, 10, 32); ok && err == nil {
+		exit.RestartCount = uint32(count)
+	} else if container.Metadata != nil {
+		exit.RestartCount = container.Metadata.Attempt
+	}
+
+	return exit
+}
@@ -27,39 +27,51 @@ func buildMemoryMetrics(
 	stats *cri.ContainerStats,
 ) (*gen.MemoryMetrics, time.Duration) {
 	start := time.Now()
+	return ConvertMemoryUsage(stats.Memory), time.Since(start)
+}
 
-	if stats.Memory == nil {
-		return &gen.MemoryMetrics{}, time.Since(start)
+// ConvertMemoryUsage converts the CRI memory usage of a container or a pod sandbox into a *gen.MemoryMetrics.
+//
+// Parameters:
+//   - memory *cri.MemoryUsage: the memory usage reported by the runtime (may be nil).
+//
+// Returns:
+//   - *gen.MemoryMetrics: the memory metrics, empty if memory is nil.
+func ConvertMemoryUsage(
+	memory *cri.MemoryUsage,
+) *gen.MemoryMetrics {
+	if memory == nil {
+		return &gen.MemoryMetrics{}
 	}
 
 	var workingSetBytes, availableBytes, usageBytes, rssBytes, pageFaults, majorPageFaults *wrapperspb.UInt64Value
 
-	if stats.Memory.WorkingSetBytes != nil {
-		workingSetBytes = utils.ConvertCRIUInt64(stats.Memory.WorkingSetBytes)
+	if memory.WorkingSetBytes != nil {
+		workingSetBytes = utils.ConvertCRIUInt64(memory.WorkingSetBytes)
 	}
 
-	if stats.Memory.AvailableBytes != nil {
-		availableBytes = utils.ConvertCRIUInt64(stats.Memory.AvailableBytes)
+	if memory.AvailableBytes != nil {
+		availableBytes = utils.ConvertCRIUInt64(memory.AvailableBytes)
 	}
 
-	if stats.Memory.UsageBytes != nil {
-		usageBytes = utils.ConvertCRIUInt64(stats.Memory.UsageBytes)
+	if memory.UsageBytes != nil {
+		usageBytes = utils.ConvertCRIUInt64(memory.UsageBytes)
 	}
 
-	if stats.Memory.RssBytes != nil {
-		rssBytes = utils.ConvertCRIUInt64(stats.Memory.RssBytes)
+	if memory.RssBytes != nil {
+		rssBytes = utils.ConvertCRIUInt64(memory.RssBytes)
 	}
 
-	if stats.Memory.PageFaults != nil {
-		pageFaults = utils.ConvertCRIUInt64(stats.Memory.PageFaults)
+	if memory.PageFaults != nil {
+		pageFaults = utils.ConvertCRIUInt64(memory.PageFaults)
 	}
 
-	if stats.Memory.MajorPageFaults != nil {
-		majorPageFaults = utils.ConvertCRIUInt64(stats.Memory.MajorPageFaults)
+	if memory.MajorPageFaults != nil {
+		majorPageFaults = utils.ConvertCRIUInt64(memory.MajorPageFaults)
 	}
 
-	metrics := &gen.MemoryMetrics{
-		Timestamp:       stats.Memory.Timestamp,
+	return &gen.MemoryMetrics{
+		Timestamp:       memory.Timestamp,
 		WorkingSetBytes: workingSetBytes,
 		AvailableBytes:  availableBytes,
 		UsageBytes:      usageBytes,
@@ -67,6 +79,4 @@ func buildMemoryMetrics(
 		PageFaults:      pageFaults,
 		MajorPageFaults: majorPageFaults,
 	}
-
-	return metrics, time.Since(start)
 }
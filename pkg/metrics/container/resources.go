@@ -0,0 +1,182 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// resourcesEntry is a cached ContainerResources and the time it was fetched.
+type resourcesEntry struct {
+	resources  *gen.ContainerResources
+	fetchedAt  time.Time
+	generation uint64
+}
+
+// ResourcesCache fetches the resources applied to containers from the CRI ContainerStatus, falling
+// back to the cgroup files when the runtime does not report them, and caches them for a TTL so that
+// the runtime is not queried for every container on every collection cycle. Resources only change
+// on an in-place pod resize, which is picked up once the entry expires.
+//
+// Entries of containers that are no longer listed are removed by Sweep.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type ResourcesCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	entries    map[string]resourcesEntry
+	generation uint64
+}
+
+// NewResourcesCache creates an empty ResourcesCache.
+//
+// Parameters:
+//   - ttl time.Duration: how long fetched resources are reused before being fetched again.
+//
+// Returns:
+//   - *ResourcesCache: the cache.
+func NewResourcesCache(
+	ttl time.Duration,
+) *ResourcesCache {
+	return &ResourcesCache{ttl: ttl, entries: make(map[string]resourcesEntry)}
+}
+
+// Get returns the resources of a container, from the cache or freshly fetched.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the ContainerStatus RPC.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client used to query the container status.
+//   - containerId string: the ID of the container.
+//   - cgroupPath string: the cgroup path of the container for the fallback, empty if unknown.
+//
+// Returns:
+//   - *gen.ContainerResources: the resources, with the limits and request in cores derived.
+//   - error: non-nil if the resources are neither reported by the runtime nor readable from the cgroup.
+func (c *ResourcesCache) Get(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+	containerId string,
+	cgroupPath string,
+) (*gen.ContainerResources, error) {
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[containerId]
+	if ok && now.Sub(entry.fetchedAt) < c.ttl {
+		entry.generation = c.generation
+		c.entries[containerId] = entry
+		c.mu.Unlock()
+		return entry.resources, nil
+	}
+	c.mu.Unlock()
+
+	resources, err := fetchResources(ctx, runtimeClient, containerId, cgroupPath)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.entries[containerId] = resourcesEntry{resources: resources, fetchedAt: now, generation: c.generation}
+	c.mu.Unlock()
+
+	return resources, nil
+}
+
+// Sweep removes the entries of containers for which Get was not called since the previous
+// Sweep, i.e. containers that were deleted or stopped. It is meant to be called once per
+// collection cycle, after a successful container listing.
+func (c *ResourcesCache) Sweep() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, entry := range c.entries {
+		if entry.generation != c.generation {
+			delete(c.entries, id)
+		}
+	}
+	c.generation++
+}
+
+// fetchResources reads the resources of a container from the CRI ContainerStatus (ContainerResources.Linux),
+// or from its cgroup files when the runtime does not report them.
+func fetchResources(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+	containerId string,
+	cgroupPath string,
+) (*gen.ContainerResources, error) {
+	var resources *gen.ContainerResources
+
+	resp, criErr := runtimeClient.ContainerStatus(ctx, &cri.ContainerStatusRequest{ContainerId: containerId})
+	if criErr == nil && resp.Status != nil && resp.Status.Resources != nil && resp.Status.Resources.Linux != nil {
+		linux := resp.Status.Resources.Linux
+		resources = &gen.ContainerResources{
+			Source:        "cri",
+			CpuShares:     uint64(max(linux.CpuShares, 0)),
+			CpuPeriodUsec: uint64(max(linux.CpuPeriod, 0)),
+			CpusetCpus:    linux.CpusetCpus,
+			CpusetMems:    linux.CpusetMems,
+		}
+		// Zero or -1 mean unlimited.
+		if linux.CpuQuota > 0 {
+			resources.CpuQuotaUsec = wrapperspb.UInt64(uint64(linux.CpuQuota))
+		}
+		if linux.MemoryLimitInBytes > 0 {
+			resources.MemoryLimitBytes = wrapperspb.UInt64(uint64(linux.MemoryLimitInBytes))
+		}
+	} else if cgroupPath != "" {
+		var err error
+		if resources, err = cgroup.ReadResources(cgroupPath); err != nil {
+			return nil, fmt.Errorf("failed to read resources of container %s from its cgroup: %v", containerId, err)
+		}
+		resources.Source = "cgroup"
+	} else if criErr != nil {
+		return nil, fmt.Errorf("failed to get status of container %s: %v", containerId, criErr)
+	} else {
+		return nil, fmt.Errorf("no resources reported for container %s and no cgroup found", containerId)
+	}
+
+	if resources.CpuQuotaUsec != nil && resources.CpuPeriodUsec > 0 {
+		resources.CpuLimitCores = wrapperspb.Double(float64(resources.CpuQuotaUsec.Value) / float64(resources.CpuPeriodUsec))
+	}
+	resources.CpuRequestCores = float64(resources.CpuShares) / 1024
+
+	return resources, nil
+}
+
+// ApplyResources sets the resources of a container in its metrics, together with its CPU and
+// memory usage as a percentage of its limits.
+//
+// CPU usage is taken from the rate computed by the agent, falling back to the runtime's
+// UsageNanoCores; memory usage is the working set. Percentages are left unset when the
+// container has no limit or the usage is unknown.
+//
+// Parameters:
+//   - metrics *gen.ContainerMetrics: the container metrics, with CpuMetrics and MemoryMetrics set.
+//   - resources *gen.ContainerResources: the resources of the container, as returned by ResourcesCache.Get.
+func ApplyResources(
+	metrics *gen.ContainerMetrics,
+	resources *gen.ContainerResources,
+) {
+	metrics.Resources = resources
+
+	if limit := resources.CpuLimitCores; limit != nil && limit.Value > 0 && metrics.CpuMetrics != nil {
+		if cores := metrics.CpuMetrics.UsageCores; cores != nil {
+			metrics.CpuLimitPercent = wrapperspb.Double(cores.Value / limit.Value * 100)
+		} else if nanoCores := metrics.CpuMetrics.UsageNanoCores; nanoCores != nil {
+			metrics.CpuLimitPercent = wrapperspb.Double(float64(nanoCores.Value) / 1e9 / limit.Value * 100)
+		}
+	}
+
+	if limit := resources.MemoryLimitBytes; limit != nil && limit.Value > 0 && metrics.MemoryMetrics != nil {
+		if workingSet := metrics.MemoryMetrics.WorkingSetBytes; workingSet != nil {
+			metrics.MemoryLimitPercent = wrapperspb.Double(float64(workingSet.Value) / float64(limit.Value) * 100)
+		}
+	}
+}
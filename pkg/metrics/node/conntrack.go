@@ -0,0 +1,158 @@
+package node
+
+import (
+	"bufio"
+	"errors"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// conntrackStat holds the per-CPU counters of /proc/net/stat/nf_conntrack, summed over CPUs.
+type conntrackStat struct {
+	insertFailed  uint64
+	drop          uint64
+	earlyDrop     uint64
+	searchRestart uint64
+	invalid       uint64
+	at            time.Time
+}
+
+// ConntrackSampler reports the usage of the conntrack table and derives the rates of the
+// netfilter pressure counters from two consecutive samples.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type ConntrackSampler struct {
+	mu   sync.Mutex
+	prev *conntrackStat
+}
+
+// NewConntrackSampler creates a ConntrackSampler primed with the current counters.
+//
+// Returns:
+//   - *ConntrackSampler: the primed sampler.
+func NewConntrackSampler() *ConntrackSampler {
+	s := &ConntrackSampler{}
+	if stat, err := readConntrackStat(); err == nil {
+		s.prev = stat
+	}
+	return s
+}
+
+// Sample reads the conntrack table usage and counters and builds a ConntrackMetrics message.
+//
+// When the nf_conntrack module is not loaded, /proc/sys/net/netfilter/nf_conntrack_count does
+// not exist: the sampler then returns neither metrics nor error. The per-CPU counters are read
+// from the host network namespace through /proc/1/net when accessible, but /proc/sys/net always
+// reflects the namespace of the agent: the table usage is the host's only with hostNetwork.
+//
+// Returns:
+//   - *gen.ConntrackMetrics: table usage, cumulative counters and their rates, or nil without conntrack.
+//   - error: non-nil if the conntrack files exist but cannot be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *ConntrackSampler) Sample() (*gen.ConntrackMetrics, error, time.Duration) {
+	start := time.Now()
+
+	count, err := utils.ReadUint64File(utils.HostProc("sys", "net", "netfilter", "nf_conntrack_count"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, time.Since(start)
+	}
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	maxEntries, err := utils.ReadUint64File(utils.HostProc("sys", "net", "netfilter", "nf_conntrack_max"))
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	metrics := &gen.ConntrackMetrics{
+		Entries:    count,
+		MaxEntries: maxEntries,
+	}
+	if maxEntries > 0 {
+		metrics.UtilizationPercent = float64(count) / float64(maxEntries) * 100
+	}
+
+	stat, err := readConntrackStat()
+	if errors.Is(err, fs.ErrNotExist) {
+		return metrics, nil, time.Since(start)
+	}
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	metrics.InsertFailed = stat.insertFailed
+	metrics.Drop = stat.drop
+	metrics.EarlyDrop = stat.earlyDrop
+	metrics.SearchRestart = stat.searchRestart
+	metrics.Invalid = stat.invalid
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if prev := s.prev; prev != nil {
+		elapsed := stat.at.Sub(prev.at)
+		metrics.InsertFailedPerSecond = utils.CounterRate(stat.insertFailed, prev.insertFailed, elapsed)
+		metrics.DropPerSecond = utils.CounterRate(stat.drop, prev.drop, elapsed)
+		metrics.EarlyDropPerSecond = utils.CounterRate(stat.earlyDrop, prev.earlyDrop, elapsed)
+		metrics.SearchRestartPerSecond = utils.CounterRate(stat.searchRestart, prev.searchRestart, elapsed)
+		metrics.InvalidPerSecond = utils.CounterRate(stat.invalid, prev.invalid, elapsed)
+	}
+	s.prev = stat
+
+	return metrics, nil, time.Since(start)
+}
+
+// readConntrackStat parses /proc/net/stat/nf_conntrack, made of a header line with the counter
+// names followed by one line of hexadecimal values per CPU. The set and order of the columns
+// depend on the kernel version, so columns are looked up by name.
+//
+// Returns:
+//   - *conntrackStat: the counters summed over CPUs, timestamped with the read time.
+//   - error: non-nil if the file cannot be read.
+func readConntrackStat() (*conntrackStat, error) {
+	file, err := os.Open(utils.HostProcNet("stat/nf_conntrack"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	stat := &conntrackStat{at: time.Now()}
+	counters := map[string]*uint64{
+		"insert_failed":  &stat.insertFailed,
+		"drop":           &stat.drop,
+		"early_drop":     &stat.earlyDrop,
+		"search_restart": &stat.searchRestart,
+		"invalid":        &stat.invalid,
+	}
+
+	var header []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if header == nil {
+			header = fields
+			continue
+		}
+
+		for i := 0; i < len(fields) && i < len(header); i++ {
+			counter, ok := counters[header[i]]
+			if !ok {
+				continue
+			}
+			if value, err := strconv.ParseUint(fields[i], 16, 64); err == nil {
+				*counter += value
+			}
+		}
+	}
+
+	return stat, scanner.Err()
+}
@@ -1,48 +1,202 @@
 package node
 
 import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"github.com/shirou/gopsutil/v3/cpu"
 )
 
-// listCpuInfos combines static CPU metadata and usage percentages into a slice of CpuInfo messages.
+// cpuUsage is the CPU usage of one logical CPU (or of all of them) over a sampling interval.
+type cpuUsage struct {
+	usage float64       // Busy percentage (100 - idle - iowait)
+	times *gen.CpuTimes // Breakdown by mode
+}
+
+// CpuSampler computes CPU usage from the /proc/stat counters of two consecutive samples.
+//
+// Unlike cpu.PercentWithContext with a zero interval, which compares against hidden global
+// state shared by every caller, CpuSampler owns its previous counters. Usage is therefore
+// computed over the actual interval between two collection cycles, per logical CPU and in
+// total, with a breakdown by mode (user, system, iowait, irq, softirq, steal, guest, ...).
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type CpuSampler struct {
+	mu         sync.Mutex
+	prevTotal  *cpu.TimesStat
+	prevPerCpu map[string]cpu.TimesStat
+}
+
+// NewCpuSampler creates a CpuSampler and primes it with the current counters,
+// so that the first collection cycle already reports usage over a real interval.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the /proc/stat reads.
+//
+// Returns:
+//   - *CpuSampler: the primed sampler.
+func NewCpuSampler(
+	ctx context.Context,
+) *CpuSampler {
+	s := &CpuSampler{}
+	_, _, _, _ = s.Sample(ctx)
+	return s
+}
+
+// Sample reads the current CPU counters and computes usage since the previous sample.
+//
+// On the very first call (no previous sample), usage is computed over the counters
+// accumulated since boot.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the /proc/stat reads.
+//
+// Returns:
+//   - cpuUsage: aggregated usage across all logical CPUs.
+//   - map[int32]cpuUsage: usage per logical CPU, keyed by CPU index.
+//   - error: non-nil if the counters could not be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *CpuSampler) Sample(
+	ctx context.Context,
+) (cpuUsage, map[int32]cpuUsage, error, time.Duration) {
+	start := time.Now()
+
+	totals, err := cpu.TimesWithContext(ctx, false)
+	if err != nil {
+		return cpuUsage{}, nil, err, time.Since(start)
+	}
+	perCpu, err := cpu.TimesWithContext(ctx, true)
+	if err != nil {
+		return cpuUsage{}, nil, err, time.Since(start)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var total cpuUsage
+	if len(totals) > 0 {
+		var prev cpu.TimesStat
+		if s.prevTotal != nil {
+			prev = *s.prevTotal
+		}
+		total = computeCpuUsage(prev, totals[0])
+		s.prevTotal = &totals[0]
+	}
+
+	usages := make(map[int32]cpuUsage, len(perCpu))
+	prevPerCpu := make(map[string]cpu.TimesStat, len(perCpu))
+	for _, t := range perCpu {
+		index, err := strconv.Atoi(strings.TrimPrefix(t.CPU, "cpu"))
+		if err != nil {
+			continue
+		}
+		usages[int32(index)] = computeCpuUsage(s.prevPerCpu[t.CPU], t)
+		prevPerCpu[t.CPU] = t
+	}
+	s.prevPerCpu = prevPerCpu
+
+	return total, usages, nil, time.Since(start)
+}
+
+// computeCpuUsage computes the busy percentage and the per-mode breakdown between two samples.
+//
+// In /proc/stat, user and nice already include guest and guest_nice time: they are subtracted
+// so that every tick is counted once and the modes add up to 100. Counters that went backwards
+// (e.g., iowait on some kernels) are treated as zero.
+//
+// Parameters:
+//   - prev cpu.TimesStat: the previous counters (zero value for counters since boot).
+//   - cur cpu.TimesStat: the current counters.
+//
+// Returns:
+//   - cpuUsage: busy percentage and breakdown by mode. All zero if no time elapsed.
+func computeCpuUsage(
+	prev cpu.TimesStat,
+	cur cpu.TimesStat,
+) cpuUsage {
+	delta := func(c, p float64) float64 {
+		if c < p {
+			return 0
+		}
+		return c - p
+	}
+
+	guest := delta(cur.Guest, prev.Guest)
+	guestNice := delta(cur.GuestNice, prev.GuestNice)
+	user := delta(cur.User, prev.User) - guest
+	if user < 0 {
+		user = 0
+	}
+	nice := delta(cur.Nice, prev.Nice) - guestNice
+	if nice < 0 {
+		nice = 0
+	}
+	system := delta(cur.System, prev.System)
+	idle := delta(cur.Idle, prev.Idle)
+	iowait := delta(cur.Iowait, prev.Iowait)
+	irq := delta(cur.Irq, prev.Irq)
+	softirq := delta(cur.Softirq, prev.Softirq)
+	steal := delta(cur.Steal, prev.Steal)
+
+	total := user + nice + system + idle + iowait + irq + softirq + steal + guest + guestNice
+	if total <= 0 {
+		return cpuUsage{times: &gen.CpuTimes{}}
+	}
+
+	percent := func(v float64) float64 { return v / total * 100 }
+
+	return cpuUsage{
+		usage: 100 - percent(idle) - percent(iowait),
+		times: &gen.CpuTimes{
+			User:      percent(user),
+			Nice:      percent(nice),
+			System:    percent(system),
+			Idle:      percent(idle),
+			Iowait:    percent(iowait),
+			Irq:       percent(irq),
+			Softirq:   percent(softirq),
+			Steal:     percent(steal),
+			Guest:     percent(guest),
+			GuestNice: percent(guestNice),
+		},
+	}
+}
+
+// listCpuInfos combines static CPU metadata and sampled usage into a slice of CpuInfo messages.
 //
-// It assumes that both input slices are indexed by logical CPU order, and only processes up to the
-// minimum of their lengths to avoid panics due to slice size mismatch.
+// CPU metadata is matched to usage by logical CPU index, so offline CPUs (present in the
+// metadata but absent from /proc/stat) are reported without usage data.
 //
 // Each resulting *gen.CpuInfo message contains:
 //   - CPU index (logical core number)
 //   - Model name, vendor ID, physical/core IDs
 //   - Clock speed in MHz
 //   - Number of physical cores (as reported)
-//   - Usage percentage over the sampled interval
+//   - Usage percentage and breakdown by mode over the sampled interval
 //
 // Parameters:
 //   - cpuInfo []cpu.InfoStat:
 //     Slice of CPU metadata structs, typically returned by gopsutil's cpu.InfoWithContext.
-//   - cpuPercents []float64:
-//     Slice of usage percentages, typically returned by cpu.PercentWithContext with `percpu=true`.
+//   - cpuUsages map[int32]cpuUsage:
+//     Usage per logical CPU index, as returned by CpuSampler.Sample.
 //
 // Returns:
 //   - []*gen.CpuInfo: A slice of protobuf CpuInfo messages representing each logical CPU core's metadata and usage.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func listCpuInfos(
 	cpuInfo []cpu.InfoStat,
-	cpuPercents []float64,
+	cpuUsages map[int32]cpuUsage,
 ) ([]*gen.CpuInfo, time.Duration) {
 	start := time.Now()
 
-	var cpuInfos []*gen.CpuInfo
-	minLen := len(cpuInfo)
-
-	if len(cpuPercents) < minLen {
-		minLen = len(cpuPercents)
-	}
+	cpuInfos := make([]*gen.CpuInfo, 0, len(cpuInfo))
 
-	for i := 0; i < minLen; i++ {
-		ci := cpuInfo[i]
+	for _, ci := range cpuInfo {
+		usage := cpuUsages[ci.CPU]
 		cpuInfos = append(cpuInfos, &gen.CpuInfo{
 			Model:      ci.ModelName,
 			Cores:      ci.Cores,
@@ -51,7 +205,8 @@ func listCpuInfos(
 			PhysicalId: ci.PhysicalID,
 			CoreId:     ci.CoreID,
 			Cpu:        ci.CPU,
-			Usage:      cpuPercents[i],
+			Usage:      usage.usage,
+			Times:      usage.times,
 		})
 	}
 
@@ -1,72 +1,240 @@
 package node
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/kubensage/kubensage-agent/pkg/cli"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"github.com/shirou/gopsutil/v3/disk"
 )
 
-// listDiskUsages returns disk usage metrics for each valid, real filesystem mountpoint
-// provided in the list of disk partitions.
+// listDiskUsages returns disk usage metrics for each filesystem selected from the host mount table.
 //
-// It filters out virtual or non-persistent filesystems (e.g., tmpfs, procfs) using
-// the isRealFilesystem check, and skips partitions where usage data is unavailable
-// or total capacity is reported as zero.
+// Mounts are filtered with the configured fstype and mountpoint globs and deduplicated by
+// device (see selectFilesystems). Filesystems where usage data is unavailable or total
+// capacity is reported as zero are skipped, except the kubelet and runtime roots.
 //
 // Each returned *gen.DiskUsage includes fields such as device name, mountpoint,
-// filesystem type, total space, used and free space, and percentage used.
+// filesystem type, total space, used and free space, percentage used, and inode usage.
 //
 // Parameters:
 //
-//   - partitions []disk.PartitionStat:
-//     A slice of partition records, typically retrieved via gopsutil's disk.Partitions().
+//   - mounts []mountInfo:
+//     The host mount table, as returned by readMountInfo.
+//
+//   - agentCfg *cli.AgentConfig:
+//     Agent configuration holding the filesystem filters, root directories and host filesystem prefix.
 //
 // Returns:
-//   - []*gen.DiskUsage: a slice of usage summaries, one for each real filesystem with retrievable stats.
+//   - []*gen.DiskUsage: a slice of usage summaries, one for each selected filesystem with retrievable stats.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func listDiskUsages(
-	partitions []disk.PartitionStat,
+	mounts []mountInfo,
+	agentCfg *cli.AgentConfig,
 ) ([]*gen.DiskUsage, time.Duration) {
 	start := time.Now()
 
-	diskUsages := make([]*gen.DiskUsage, 0, len(partitions))
+	filesystems := selectFilesystems(mounts, agentCfg)
+	diskUsages := make([]*gen.DiskUsage, 0, len(filesystems))
+
+	for _, fs := range filesystems {
+		flagged := fs.kubeletRoot || fs.runtimeRoot
 
-	for _, p := range partitions {
-		if !isRealFilesystem(p.Fstype) {
+		usage, err := disk.Usage(filepath.Join("/", agentCfg.HostRoot, fs.mountpoint))
+		if !flagged && (err != nil || usage.Total == 0) {
 			continue
 		}
 
-		usage, err := disk.Usage(p.Mountpoint)
-		if err != nil || usage.Total == 0 {
-			continue
+		diskUsage := &gen.DiskUsage{
+			Device:           fs.source,
+			Mountpoint:       fs.mountpoint,
+			Fstype:           fs.fstype,
+			BlockDevice:      blockDeviceName(fs.device),
+			OtherMountpoints: fs.otherMountpoints,
+			KubeletRoot:      fs.kubeletRoot,
+			RuntimeRoot:      fs.runtimeRoot,
+		}
+		if err == nil {
+			diskUsage.Total = usage.Total
+			diskUsage.Free = usage.Free
+			diskUsage.Used = usage.Used
+			diskUsage.UsedPercent = usage.UsedPercent
+			diskUsage.InodesTotal = usage.InodesTotal
+			diskUsage.InodesUsed = usage.InodesUsed
+			diskUsage.InodesFree = usage.InodesFree
+			diskUsage.InodesUsedPercent = usage.InodesUsedPercent
 		}
 
-		diskUsages = append(diskUsages, &gen.DiskUsage{
-			Device:      p.Device,
-			Mountpoint:  p.Mountpoint,
-			Fstype:      p.Fstype,
-			Total:       usage.Total,
-			Free:        usage.Free,
-			Used:        usage.Used,
-			UsedPercent: usage.UsedPercent,
-		})
+		diskUsages = append(diskUsages, diskUsage)
 	}
 
 	return diskUsages, time.Since(start)
 }
 
+// blockDeviceUsage holds the counters and topology of one block device and the values derived over a sampling interval.
+type blockDeviceUsage struct {
+	counters       disk.IOCountersStat
+	isPartition    bool
+	parent         string
+	summed         bool
+	readBytesRate  float64
+	writeBytesRate float64
+	readOpsRate    float64
+	writeOpsRate   float64
+	utilization    float64
+	await          float64
+	readAwait      float64
+	writeAwait     float64
+}
+
+// DiskSampler reads per-block-device I/O counters and derives rates, utilization and
+// latencies from the counters of two consecutive samples.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type DiskSampler struct {
+	mu     sync.Mutex
+	prev   map[string]disk.IOCountersStat
+	prevAt time.Time
+}
+
+// NewDiskSampler creates a DiskSampler primed with the current counters.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the priming read.
+//
+// Returns:
+//   - *DiskSampler: the primed sampler.
+func NewDiskSampler(
+	ctx context.Context,
+) *DiskSampler {
+	s := &DiskSampler{}
+	_, _, _ = s.Sample(ctx)
+	return s
+}
+
+// Sample reads the counters of every block device and computes the values since the previous sample.
+// Derived values are zero on the first sample and for devices that appeared since the previous one.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the /proc/diskstats read.
+//
+// Returns:
+//   - map[string]blockDeviceUsage: counters, topology and derived values, keyed by device name.
+//   - error: non-nil if the counters could not be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *DiskSampler) Sample(
+	ctx context.Context,
+) (map[string]blockDeviceUsage, error, time.Duration) {
+	start := time.Now()
+
+	counters, err := disk.IOCountersWithContext(ctx)
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := now.Sub(s.prevAt)
+	usages := make(map[string]blockDeviceUsage, len(counters))
+
+	for name, stat := range counters {
+		usage := blockDeviceUsage{counters: stat}
+		usage.isPartition, usage.parent, usage.summed = readBlockTopology(name)
+
+		if p, ok := s.prev[name]; ok && elapsed > 0 {
+			usage.readBytesRate = utils.CounterRate(stat.ReadBytes, p.ReadBytes, elapsed)
+			usage.writeBytesRate = utils.CounterRate(stat.WriteBytes, p.WriteBytes, elapsed)
+			usage.readOpsRate = utils.CounterRate(stat.ReadCount, p.ReadCount, elapsed)
+			usage.writeOpsRate = utils.CounterRate(stat.WriteCount, p.WriteCount, elapsed)
+
+			ioTime := utils.CounterDelta(stat.IoTime, p.IoTime)
+			usage.utilization = min(float64(ioTime)/(elapsed.Seconds()*1000)*100, 100)
+
+			reads := utils.CounterDelta(stat.ReadCount, p.ReadCount)
+			writes := utils.CounterDelta(stat.WriteCount, p.WriteCount)
+			readTime := utils.CounterDelta(stat.ReadTime, p.ReadTime)
+			writeTime := utils.CounterDelta(stat.WriteTime, p.WriteTime)
+
+			if reads > 0 {
+				usage.readAwait = float64(readTime) / float64(reads)
+			}
+			if writes > 0 {
+				usage.writeAwait = float64(writeTime) / float64(writes)
+			}
+			if reads+writes > 0 {
+				usage.await = float64(readTime+writeTime) / float64(reads+writes)
+			}
+		}
+
+		usages[name] = usage
+	}
+
+	s.prev = counters
+	s.prevAt = now
+
+	return usages, nil, time.Since(start)
+}
+
+// readBlockTopology determines from /sys/class/block whether a block device is a partition,
+// which disk holds it, and whether it is a physical disk summed in DiskIOSummary.
+//
+// A device is summed when it is a whole disk that is not stacked on other block devices
+// (device-mapper and md devices list their underlying devices in "slaves") and is not a
+// loop, ram or zram device.
+//
+// Parameters:
+//   - name string: the kernel name of the device (e.g., "sda1").
+//
+// Returns:
+//   - bool: true if the device is a partition.
+//   - string: the name of the whole disk holding the partition, empty for whole disks.
+//   - bool: true if the device is summed in DiskIOSummary.
+func readBlockTopology(
+	name string,
+) (bool, string, bool) {
+	dir := utils.HostSys("class", "block", name)
+
+	if _, err := os.Stat(filepath.Join(dir, "partition")); err == nil {
+		var parent string
+		// The device link resolves to .../block/<disk>/<partition>.
+		if target, err := filepath.EvalSymlinks(dir); err == nil {
+			parent = filepath.Base(filepath.Dir(target))
+		}
+		return true, parent, false
+	}
+
+	for _, prefix := range []string{"loop", "ram", "zram"} {
+		if strings.HasPrefix(name, prefix) {
+			return false, "", false
+		}
+	}
+
+	if slaves, err := os.ReadDir(filepath.Join(dir, "slaves")); err == nil && len(slaves) > 0 {
+		return false, "", false
+	}
+
+	return false, "", true
+}
+
 // buildDiskIOSummary aggregates global disk I/O statistics from a map of per-device counters.
 //
-// It sums read and write bytes, as well as read and write operation counts, across all devices,
-// excluding loop and RAM-based devices (e.g., /dev/loop*, /dev/ram*) which are considered ephemeral
-// or not relevant for disk I/O reporting.
+// It sums read and write bytes, as well as read and write operation counts and their rates,
+// across physical whole disks only (see readBlockTopology). Partitions, stacked devices
+// (device-mapper, md) and loop or RAM-based devices are skipped, so that no I/O is counted twice.
 //
 // Parameters:
 //
-//   - counters map[string]disk.IOCountersStat:
-//     A map of device names to I/O statistics, typically retrieved from gopsutil's disk.IOCountersWithContext().
+//   - usages map[string]blockDeviceUsage:
+//     A map of device names to I/O statistics, as returned by DiskSampler.Sample.
 //
 // Returns:
 //   - *gen.DiskIOSummary:
@@ -74,81 +242,95 @@ func listDiskUsages(
 //     across all relevant block devices.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func buildDiskIOSummary(
-	counters map[string]disk.IOCountersStat,
+	usages map[string]blockDeviceUsage,
 ) (*gen.DiskIOSummary, time.Duration) {
 	start := time.Now()
 
-	var readBytes, writeBytes, readOps, writeOps uint64
+	summary := &gen.DiskIOSummary{}
 
-	for name, stat := range counters {
-		if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+	for _, usage := range usages {
+		if !usage.summed {
 			continue
 		}
 
-		readBytes += stat.ReadBytes
-		writeBytes += stat.WriteBytes
-		readOps += stat.ReadCount
-		writeOps += stat.WriteCount
-	}
-
-	summary := &gen.DiskIOSummary{
-		TotalReadBytes:  readBytes,
-		TotalWriteBytes: writeBytes,
-		TotalReadOps:    readOps,
-		TotalWriteOps:   writeOps,
+		summary.TotalReadBytes += usage.counters.ReadBytes
+		summary.TotalWriteBytes += usage.counters.WriteBytes
+		summary.TotalReadOps += usage.counters.ReadCount
+		summary.TotalWriteOps += usage.counters.WriteCount
+		summary.ReadBytesPerSecond += usage.readBytesRate
+		summary.WriteBytesPerSecond += usage.writeBytesRate
+		summary.ReadOpsPerSecond += usage.readOpsRate
+		summary.WriteOpsPerSecond += usage.writeOpsRate
 	}
 
 	return summary, time.Since(start)
 }
 
-// isRealFilesystem determines whether a given filesystem type represents a persistent
-// or mountable storage backend.
-//
-// It is primarily used to filter out non-storage or ephemeral filesystems such as
-// "tmpfs", "proc", or "sysfs", which should not be included in disk usage metrics.
-//
-// The function compares the input type against a known list of real filesystems used
-// across various platforms, including:
-//   - Linux (e.g., ext4, xfs, btrfs)
-//   - Windows (e.g., ntfs, fat32)
-//   - macOS/Unix (e.g., apfs, hfs)
-//   - BSD (e.g., hammer2, zfs)
-//   - Network or clustered FS (e.g., nfs, ceph, glusterfs)
+// listDiskIOStats converts per-device usage into DiskIOStats messages, sorted by device name,
+// and maps each device to the mountpoints of the DiskUsage entries it backs.
 //
-// The comparison is case-insensitive.
+// DiskUsage entries are matched by the kernel name of their block device, falling back to
+// the device path with symlinks resolved, so that "/dev/mapper/vg-root" is attributed to "dm-0".
 //
 // Parameters:
-//   - fstype string:
-//     Filesystem type string (typically from a mount or partition record)
+//   - usages map[string]blockDeviceUsage: per-device usage, as returned by DiskSampler.Sample (may be nil).
+//   - diskUsages []*gen.DiskUsage: the filesystem usages of the same collection cycle.
 //
 // Returns:
-//   - bool:
-//     True if the filesystem type is considered persistent/mountable, false otherwise.
-func isRealFilesystem(
-	fstype string,
-) bool {
-	realFS := []string{
-		// Linux
-		"ext4", "ext3", "ext2", "xfs", "btrfs", "zfs", "f2fs", "nilfs2",
-
-		// Windows
-		"ntfs", "exfat", "fat32", "vfat", "fat", "refs",
-
-		// macOS / Unix
-		"apfs", "hfs", "hfs+", "ufs", "ffs",
-
-		// BSD
-		"hammer", "hammer2", "zfs", "ufs2",
-
-		// Network / Cluster FS
-		"nfs", "nfs4", "cifs", "smbfs", "glusterfs", "ceph", "lustre", "ocfs2", "gfs2",
-	}
+//   - []*gen.DiskIOStats: one message per block device.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func listDiskIOStats(
+	usages map[string]blockDeviceUsage,
+	diskUsages []*gen.DiskUsage,
+) ([]*gen.DiskIOStats, time.Duration) {
+	start := time.Now()
 
-	for _, fs := range realFS {
-		if strings.EqualFold(fstype, fs) {
-			return true
+	mountpoints := make(map[string][]string)
+	for _, du := range diskUsages {
+		name := du.BlockDevice
+		if name == "" {
+			name = du.Device
+			if target, err := filepath.EvalSymlinks(du.Device); err == nil {
+				name = target
+			}
+			name = filepath.Base(name)
 		}
+		mountpoints[name] = append(mountpoints[name], du.Mountpoint)
+	}
+
+	stats := make([]*gen.DiskIOStats, 0, len(usages))
+
+	for name, usage := range usages {
+		c := usage.counters
+		stats = append(stats, &gen.DiskIOStats{
+			Name:                name,
+			IsPartition:         usage.isPartition,
+			Parent:              usage.parent,
+			Mountpoints:         mountpoints[name],
+			CountedInSummary:    usage.summed,
+			ReadBytes:           c.ReadBytes,
+			WriteBytes:          c.WriteBytes,
+			ReadOps:             c.ReadCount,
+			WriteOps:            c.WriteCount,
+			MergedReadOps:       c.MergedReadCount,
+			MergedWriteOps:      c.MergedWriteCount,
+			ReadTimeMs:          c.ReadTime,
+			WriteTimeMs:         c.WriteTime,
+			IoTimeMs:            c.IoTime,
+			WeightedIoTimeMs:    c.WeightedIO,
+			InFlight:            c.IopsInProgress,
+			ReadBytesPerSecond:  usage.readBytesRate,
+			WriteBytesPerSecond: usage.writeBytesRate,
+			ReadOpsPerSecond:    usage.readOpsRate,
+			WriteOpsPerSecond:   usage.writeOpsRate,
+			UtilizationPercent:  usage.utilization,
+			AwaitMs:             usage.await,
+			ReadAwaitMs:         usage.readAwait,
+			WriteAwaitMs:        usage.writeAwait,
+		})
 	}
 
-	return false
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Name < stats[j].Name })
+
+	return stats, time.Since(start)
 }
@@ -0,0 +1,285 @@
+package node
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kubensage/kubensage-agent/pkg/cli"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+)
+
+// mountInfo is one line of /proc/<pid>/mountinfo.
+type mountInfo struct {
+	id         int    // Unique mount ID
+	device     string // major:minor of the device backing the mount
+	root       string // Path of the directory of the filesystem mounted at mountpoint (not "/" for bind mounts)
+	mountpoint string // Mountpoint relative to the root of the host
+	fstype     string // Filesystem type (e.g., "ext4", "overlay", "fuse.sshfs")
+	source     string // Mount source (e.g., "/dev/sda1", "tmpfs")
+}
+
+// filesystem is a filesystem selected for DiskUsage reporting, deduplicated by device.
+type filesystem struct {
+	mountInfo
+	otherMountpoints []string // Selected bind mounts of the same device
+	kubeletRoot      bool     // Holds the kubelet root directory
+	runtimeRoot      bool     // Holds a container runtime root directory
+}
+
+// readMountInfo parses the mount table of the host.
+//
+// Like gopsutil, it reads the mountinfo of PID 1 under the host /proc, which lists the host
+// mounts when the agent runs in a container sharing the host PID namespace, and falls back
+// to the mountinfo of the agent process itself.
+//
+// Returns:
+//   - []mountInfo: the mounts, in mount table order.
+//   - error: non-nil if no mountinfo file can be read.
+func readMountInfo() ([]mountInfo, error) {
+	file, err := os.Open(utils.HostProc("1", "mountinfo"))
+	if err != nil {
+		if file, err = os.Open(utils.HostProc("self", "mountinfo")); err != nil {
+			return nil, err
+		}
+	}
+	defer func() { _ = file.Close() }()
+
+	var mounts []mountInfo
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// 36 35 98:0 /mnt1 /mnt2 rw,noatime master:1 - ext3 /dev/root rw,errors=continue
+		pre, post, ok := strings.Cut(scanner.Text(), " - ")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(pre)
+		postFields := strings.Fields(post)
+		if len(fields) < 5 || len(postFields) < 2 {
+			continue
+		}
+
+		id, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+
+		mounts = append(mounts, mountInfo{
+			id:         id,
+			device:     fields[2],
+			root:       unescapeMountPath(fields[3]),
+			mountpoint: unescapeMountPath(fields[4]),
+			fstype:     postFields[0],
+			source:     unescapeMountPath(postFields[1]),
+		})
+	}
+
+	return mounts, scanner.Err()
+}
+
+// unescapeMountPath decodes the octal escapes (e.g., "\040" for a space) used in mountinfo paths.
+func unescapeMountPath(
+	s string,
+) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if c, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// selectFilesystems filters the mount table with the configured fstype and mountpoint globs
+// and deduplicates the remaining mounts by device, so that a filesystem bind-mounted several
+// times is reported once.
+//
+// For each device, the mount of the filesystem root ("/") is preferred over bind mounts of
+// subdirectories, then the shortest mountpoint, then the lowest mount ID. The filesystems
+// holding the kubelet root and the container runtime roots are flagged and always selected,
+// regardless of the filters.
+//
+// Parameters:
+//   - mounts []mountInfo: the host mount table.
+//   - agentCfg *cli.AgentConfig: agent configuration holding the filters and the root directories.
+//
+// Returns:
+//   - []*filesystem: the selected filesystems, sorted by mountpoint.
+func selectFilesystems(
+	mounts []mountInfo,
+	agentCfg *cli.AgentConfig,
+) []*filesystem {
+	kubeletDevice := deviceOf(mounts, agentCfg.KubeletRootDir, agentCfg.HostRoot)
+	runtimeDevices := make(map[string]bool)
+	for _, dir := range agentCfg.RuntimeRootDirs {
+		if device := deviceOf(mounts, dir, agentCfg.HostRoot); device != "" {
+			runtimeDevices[device] = true
+		}
+	}
+
+	candidates := make(map[string][]mountInfo)
+	for _, m := range mounts {
+		flagged := m.device == kubeletDevice || runtimeDevices[m.device]
+		if flagged || isSelectedMount(m, agentCfg) {
+			candidates[m.device] = append(candidates[m.device], m)
+		}
+	}
+
+	filesystems := make([]*filesystem, 0, len(candidates))
+
+	for device, ms := range candidates {
+		sort.Slice(ms, func(i, j int) bool {
+			if (ms[i].root == "/") != (ms[j].root == "/") {
+				return ms[i].root == "/"
+			}
+			if len(ms[i].mountpoint) != len(ms[j].mountpoint) {
+				return len(ms[i].mountpoint) < len(ms[j].mountpoint)
+			}
+			return ms[i].id < ms[j].id
+		})
+
+		fs := &filesystem{
+			mountInfo:   ms[0],
+			kubeletRoot: device == kubeletDevice,
+			runtimeRoot: runtimeDevices[device],
+		}
+		for _, m := range ms[1:] {
+			if isSelectedMount(m, agentCfg) {
+				fs.otherMountpoints = append(fs.otherMountpoints, m.mountpoint)
+			}
+		}
+
+		filesystems = append(filesystems, fs)
+	}
+
+	sort.Slice(filesystems, func(i, j int) bool { return filesystems[i].mountpoint < filesystems[j].mountpoint })
+
+	return filesystems
+}
+
+// isSelectedMount applies the fstype and mountpoint include/exclude globs to a mount.
+//
+// A mount is selected when it matches an include glob of each kind (or the include list is
+// empty) and matches no exclude glob.
+func isSelectedMount(
+	m mountInfo,
+	agentCfg *cli.AgentConfig,
+) bool {
+	if len(agentCfg.FsIncludeTypes) > 0 && !matchAnyGlob(agentCfg.FsIncludeTypes, m.fstype) {
+		return false
+	}
+	if matchAnyGlob(agentCfg.FsExcludeTypes, m.fstype) {
+		return false
+	}
+	if len(agentCfg.FsIncludeMountpoints) > 0 && !matchAnyGlob(agentCfg.FsIncludeMountpoints, m.mountpoint) {
+		return false
+	}
+	return !matchAnyGlob(agentCfg.FsExcludeMountpoints, m.mountpoint)
+}
+
+// matchAnyGlob reports whether value matches one of the globs.
+//
+// Globs use path.Match syntax (e.g., "fuse.*"). A glob ending in "/**" matches the
+// directories matching the rest of the glob and everything below them
+// (e.g., "/var/lib/kubelet/pods/**" or "/var/lib/containerd/io.containerd.*/**").
+func matchAnyGlob(
+	globs []string,
+	value string,
+) bool {
+	for _, glob := range globs {
+		dirGlob, tree := strings.CutSuffix(glob, "/**")
+		if !tree {
+			if matched, _ := path.Match(glob, value); matched {
+				return true
+			}
+			continue
+		}
+
+		if dirGlob == "" {
+			return true
+		}
+		for p := value; p != "/" && p != "."; p = path.Dir(p) {
+			if matched, _ := path.Match(dirGlob, p); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isUnderPath reports whether p is dir or lies below it ("" and "/" both denote the root).
+func isUnderPath(
+	p string,
+	dir string,
+) bool {
+	dir = strings.TrimSuffix(dir, "/")
+	return dir == "" || p == dir || strings.HasPrefix(p, dir+"/")
+}
+
+// deviceOf returns the device of the mount holding dir, i.e. the mount with the longest
+// mountpoint that is dir or one of its parents.
+//
+// Parameters:
+//   - mounts []mountInfo: the host mount table.
+//   - dir string: an absolute directory on the host (e.g., "/var/lib/kubelet").
+//   - hostRoot string: prefix of the host filesystem, used to check that dir exists.
+//
+// Returns:
+//   - string: the major:minor of the device, or empty if dir is empty or does not exist.
+func deviceOf(
+	mounts []mountInfo,
+	dir string,
+	hostRoot string,
+) string {
+	if dir == "" {
+		return ""
+	}
+	if _, err := os.Stat(filepath.Join("/", hostRoot, dir)); err != nil {
+		return ""
+	}
+
+	var best *mountInfo
+	for i, m := range mounts {
+		if !isUnderPath(dir, m.mountpoint) {
+			continue
+		}
+		// Later mounts shadow earlier ones on the same mountpoint.
+		if best == nil || len(m.mountpoint) >= len(best.mountpoint) {
+			best = &mounts[i]
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+	return best.device
+}
+
+// blockDeviceName resolves a major:minor device number to the kernel name of the block
+// device (e.g., "8:1" to "sda1") through /sys/dev/block.
+//
+// Returns an empty string for devices that are not block devices (e.g., tmpfs, overlay).
+func blockDeviceName(
+	device string,
+) string {
+	target, err := filepath.EvalSymlinks(utils.HostSys("dev", "block", device))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(target)
+}
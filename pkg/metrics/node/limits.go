@@ -0,0 +1,230 @@
+package node
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// inotifyScanInterval is the minimum interval between two scans of the inotify usage,
+// which walks the file descriptors of every process and is much more expensive than the
+// other limits.
+const inotifyScanInterval = time.Minute
+
+// inotifyUsage is the result of a scan of the inotify instances and watches of every process.
+type inotifyUsage struct {
+	instances    uint64
+	watches      uint64
+	topInstances uint64 // Instances of the user owning the most
+	topWatches   uint64 // Watches of the user owning the most
+	at           time.Time
+}
+
+// LimitsSampler reports the usage of kernel resource limits.
+//
+// The inotify usage is rescanned at most once every inotifyScanInterval; the last scan is
+// reported in between.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type LimitsSampler struct {
+	mu      sync.Mutex
+	inotify *inotifyUsage
+}
+
+// NewLimitsSampler creates a LimitsSampler. The first inotify scan happens on the first sample.
+//
+// Returns:
+//   - *LimitsSampler: the sampler.
+func NewLimitsSampler() *LimitsSampler {
+	return &LimitsSampler{}
+}
+
+// Sample reads the kernel limits and their current usage and builds a KernelLimits message.
+//
+// Returns:
+//   - *gen.KernelLimits: the limits and usage. Values that cannot be read are left at zero.
+//   - error: non-nil if /proc/sys/fs/file-nr cannot be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *LimitsSampler) Sample() (*gen.KernelLimits, error, time.Duration) {
+	start := time.Now()
+
+	data, err := os.ReadFile(utils.HostProc("sys", "fs", "file-nr"))
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	limits := &gen.KernelLimits{}
+
+	// allocated, free (always 0 since Linux 2.6), max
+	if fields := strings.Fields(string(data)); len(fields) == 3 {
+		limits.FileHandlesAllocated, _ = strconv.ParseUint(fields[0], 10, 64)
+		limits.FileHandlesMax, _ = strconv.ParseUint(fields[2], 10, 64)
+	}
+	limits.FileHandlesUsedPercent = percentOf(limits.FileHandlesAllocated, limits.FileHandlesMax)
+
+	if load, err := readLoadAvg(); err == nil {
+		limits.Threads = load.ThreadsTotal
+	}
+	limits.Processes = countProcesses()
+
+	limits.PidMax, _ = utils.ReadUint64File(utils.HostProc("sys", "kernel", "pid_max"))
+	limits.PidUsedPercent = percentOf(limits.Threads, limits.PidMax)
+	limits.ThreadsMax, _ = utils.ReadUint64File(utils.HostProc("sys", "kernel", "threads-max"))
+	limits.ThreadsUsedPercent = percentOf(limits.Threads, limits.ThreadsMax)
+
+	limits.InotifyMaxUserInstances, _ = utils.ReadUint64File(utils.HostProc("sys", "fs", "inotify", "max_user_instances"))
+	limits.InotifyMaxUserWatches, _ = utils.ReadUint64File(utils.HostProc("sys", "fs", "inotify", "max_user_watches"))
+
+	if inotify := s.inotifyUsage(); inotify != nil {
+		limits.InotifyScanned = true
+		limits.InotifyInstances = inotify.instances
+		limits.InotifyWatches = inotify.watches
+		limits.InotifyTopUserInstances = inotify.topInstances
+		limits.InotifyTopUserWatches = inotify.topWatches
+	}
+
+	if dir, ok := cgroup.KubepodsPath("pids"); ok {
+		limits.KubepodsPidsCurrent, _ = utils.ReadUint64File(filepath.Join(dir, "pids.current"))
+		// pids.max is "max" when unlimited, which fails to parse and leaves 0.
+		limits.KubepodsPidsMax, _ = utils.ReadUint64File(filepath.Join(dir, "pids.max"))
+		limits.KubepodsPidsUsedPercent = percentOf(limits.KubepodsPidsCurrent, limits.KubepodsPidsMax)
+	}
+
+	return limits, nil, time.Since(start)
+}
+
+// inotifyUsage returns the last inotify scan, rescanning if it is older than inotifyScanInterval.
+//
+// Returns:
+//   - *inotifyUsage: the scan result, or nil if the file descriptors of the processes are not accessible.
+func (s *LimitsSampler) inotifyUsage() *inotifyUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.inotify == nil || time.Since(s.inotify.at) >= inotifyScanInterval {
+		s.inotify = scanInotify()
+	}
+	return s.inotify
+}
+
+// scanInotify counts the inotify instances and watches of every process, by looking for
+// file descriptors linked to "anon_inode:inotify" and counting the "inotify wd:" lines of
+// their fdinfo. Instances and watches are also grouped by the user owning the process,
+// since the kernel limits apply per user.
+//
+// Returns:
+//   - *inotifyUsage: the scan result, or nil if the file descriptors of PID 1 are not accessible
+//     (the agent then lacks the privileges to see other processes' descriptors).
+func scanInotify() *inotifyUsage {
+	if _, err := os.ReadDir(utils.HostProc("1", "fd")); err != nil {
+		return nil
+	}
+
+	entries, err := os.ReadDir(utils.HostProc())
+	if err != nil {
+		return nil
+	}
+
+	usage := &inotifyUsage{at: time.Now()}
+	userInstances := make(map[uint32]uint64)
+	userWatches := make(map[uint32]uint64)
+
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		dir := utils.HostProc(entry.Name())
+
+		fds, err := os.ReadDir(filepath.Join(dir, "fd"))
+		if err != nil {
+			continue
+		}
+
+		var instances, watches uint64
+		for _, fd := range fds {
+			target, err := os.Readlink(filepath.Join(dir, "fd", fd.Name()))
+			if err != nil || target != "anon_inode:inotify" {
+				continue
+			}
+			instances++
+			watches += countInotifyWatches(filepath.Join(dir, "fdinfo", fd.Name()))
+		}
+		if instances == 0 {
+			continue
+		}
+
+		usage.instances += instances
+		usage.watches += watches
+
+		if uid, err := readProcessUid(dir); err == nil {
+			userInstances[uid] += instances
+			userWatches[uid] += watches
+		}
+	}
+
+	for _, n := range userInstances {
+		usage.topInstances = max(usage.topInstances, n)
+	}
+	for _, n := range userWatches {
+		usage.topWatches = max(usage.topWatches, n)
+	}
+
+	return usage
+}
+
+// countInotifyWatches counts the "inotify wd:" lines of the fdinfo file of an inotify instance.
+func countInotifyWatches(
+	path string,
+) uint64 {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0
+	}
+	defer func() { _ = file.Close() }()
+
+	var watches uint64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "inotify wd:") {
+			watches++
+		}
+	}
+
+	return watches
+}
+
+// countProcesses counts the process directories of the host /proc.
+func countProcesses() uint64 {
+	entries, err := os.ReadDir(utils.HostProc())
+	if err != nil {
+		return 0
+	}
+
+	var processes uint64
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err == nil {
+			processes++
+		}
+	}
+	return processes
+}
+
+// percentOf returns used in percent of limit, or 0 if limit is 0 (unknown or unlimited).
+func percentOf(
+	used uint64,
+	limit uint64,
+) float64 {
+	if limit == 0 {
+		return 0
+	}
+	return float64(used) / float64(limit) * 100
+}
@@ -0,0 +1,165 @@
+package node
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// schedStat holds the scheduler counters read from /proc/stat.
+type schedStat struct {
+	procsRunning uint64
+	procsBlocked uint64
+	ctxt         uint64
+	forks        uint64
+	intr         uint64
+	at           time.Time
+}
+
+// LoadSampler collects load average and scheduler statistics, and derives context switch,
+// fork and interrupt rates from the /proc/stat counters of two consecutive samples.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type LoadSampler struct {
+	mu   sync.Mutex
+	prev *schedStat
+}
+
+// NewLoadSampler creates a LoadSampler primed with the current counters.
+//
+// Returns:
+//   - *LoadSampler: the primed sampler.
+func NewLoadSampler() *LoadSampler {
+	s := &LoadSampler{}
+	if stat, err := readSchedStat(); err == nil {
+		s.prev = stat
+	}
+	return s
+}
+
+// Sample reads /proc/loadavg and /proc/stat and builds a LoadMetrics message.
+//
+// Returns:
+//   - *gen.LoadMetrics: load averages, run queue, cumulative counters and their rates since the previous sample.
+//   - error: non-nil if either file cannot be read or parsed.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *LoadSampler) Sample() (*gen.LoadMetrics, error, time.Duration) {
+	start := time.Now()
+
+	metrics, err := readLoadAvg()
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	stat, err := readSchedStat()
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	metrics.ProcsRunning = stat.procsRunning
+	metrics.ProcsBlocked = stat.procsBlocked
+	metrics.ContextSwitches = stat.ctxt
+	metrics.Forks = stat.forks
+	metrics.Interrupts = stat.intr
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.prev != nil {
+		elapsed := stat.at.Sub(s.prev.at)
+		metrics.ContextSwitchesPerSecond = utils.CounterRate(stat.ctxt, s.prev.ctxt, elapsed)
+		metrics.ForksPerSecond = utils.CounterRate(stat.forks, s.prev.forks, elapsed)
+		metrics.InterruptsPerSecond = utils.CounterRate(stat.intr, s.prev.intr, elapsed)
+	}
+	s.prev = stat
+
+	return metrics, nil, time.Since(start)
+}
+
+// readLoadAvg parses /proc/loadavg, e.g. "0.20 0.18 0.12 1/80 11206".
+//
+// Returns:
+//   - *gen.LoadMetrics: a message with load averages and the total number of scheduling entities set.
+//   - error: non-nil if the file cannot be read or has an unexpected format.
+func readLoadAvg() (*gen.LoadMetrics, error) {
+	path := utils.HostProc("loadavg")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(string(data))
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("unexpected format of %s: %q", path, string(data))
+	}
+
+	var loads [3]float64
+	for i := range loads {
+		if loads[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	}
+
+	metrics := &gen.LoadMetrics{Load1: loads[0], Load5: loads[1], Load15: loads[2]}
+
+	if _, total, ok := strings.Cut(fields[3], "/"); ok {
+		metrics.ThreadsTotal, _ = strconv.ParseUint(total, 10, 64)
+	}
+
+	return metrics, nil
+}
+
+// readSchedStat parses the procs_running, procs_blocked, ctxt, processes and intr lines of /proc/stat.
+// Only the first value of the intr line (the total of all interrupts) is used.
+//
+// Returns:
+//   - *schedStat: the counters, timestamped with the read time.
+//   - error: non-nil if the file cannot be read.
+func readSchedStat() (*schedStat, error) {
+	file, err := os.Open(utils.HostProc("stat"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	stat := &schedStat{at: time.Now()}
+
+	scanner := bufio.NewScanner(file)
+	// The intr line lists one counter per interrupt and can exceed the default buffer size.
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch fields[0] {
+		case "procs_running":
+			stat.procsRunning = value
+		case "procs_blocked":
+			stat.procsBlocked = value
+		case "ctxt":
+			stat.ctxt = value
+		case "processes":
+			stat.forks = value
+		case "intr":
+			stat.intr = value
+		}
+	}
+
+	return stat, scanner.Err()
+}
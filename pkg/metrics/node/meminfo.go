@@ -0,0 +1,210 @@
+package node
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// vmStat holds the /proc/vmstat counters used to derive paging and reclaim rates.
+type vmStat struct {
+	swapIn        uint64
+	swapOut       uint64
+	majorFaults   uint64
+	scanned       uint64
+	scannedDirect uint64
+	stolen        uint64
+	oomKills      uint64
+	at            time.Time
+}
+
+// MemorySampler builds the detailed node memory breakdown from /proc/meminfo and derives
+// paging and reclaim rates from the /proc/vmstat counters of two consecutive samples.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type MemorySampler struct {
+	mu   sync.Mutex
+	prev *vmStat
+}
+
+// NewMemorySampler creates a MemorySampler primed with the current /proc/vmstat counters.
+//
+// Returns:
+//   - *MemorySampler: the primed sampler.
+func NewMemorySampler() *MemorySampler {
+	s := &MemorySampler{}
+	if stat, err := readVmStat(); err == nil {
+		s.prev = stat
+	}
+	return s
+}
+
+// Sample reads /proc/meminfo and /proc/vmstat and builds a NodeMemoryMetrics message.
+//
+// Returns:
+//   - *gen.NodeMemoryMetrics: the memory breakdown, cumulative paging counters and their rates.
+//   - error: non-nil if either file cannot be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *MemorySampler) Sample() (*gen.NodeMemoryMetrics, error, time.Duration) {
+	start := time.Now()
+
+	info, err := readMemInfo()
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	stat, err := readVmStat()
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+
+	metrics := &gen.NodeMemoryMetrics{
+		FreeBytes:              info["MemFree"],
+		BuffersBytes:           info["Buffers"],
+		CachedBytes:            info["Cached"],
+		ShmemBytes:             info["Shmem"],
+		SlabReclaimableBytes:   info["SReclaimable"],
+		SlabUnreclaimableBytes: info["SUnreclaim"],
+		AnonBytes:              info["AnonPages"],
+		AnonHugePagesBytes:     info["AnonHugePages"],
+		DirtyBytes:             info["Dirty"],
+		WritebackBytes:         info["Writeback"],
+		CommittedAsBytes:       info["Committed_AS"],
+		CommitLimitBytes:       info["CommitLimit"],
+		PageTablesBytes:        info["PageTables"],
+		HugePagesTotal:         info["HugePages_Total"],
+		HugePagesFree:          info["HugePages_Free"],
+		HugePagesReserved:      info["HugePages_Rsvd"],
+		HugePageSizeBytes:      info["Hugepagesize"],
+		SwapTotalBytes:         info["SwapTotal"],
+		SwapFreeBytes:          info["SwapFree"],
+		SwapCachedBytes:        info["SwapCached"],
+
+		SwapInPages:     stat.swapIn,
+		SwapOutPages:    stat.swapOut,
+		MajorPageFaults: stat.majorFaults,
+		PagesScanned:    stat.scanned,
+		PagesStolen:     stat.stolen,
+		OomKills:        stat.oomKills,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if prev := s.prev; prev != nil {
+		elapsed := stat.at.Sub(prev.at)
+		metrics.SwapInPagesPerSecond = utils.CounterRate(stat.swapIn, prev.swapIn, elapsed)
+		metrics.SwapOutPagesPerSecond = utils.CounterRate(stat.swapOut, prev.swapOut, elapsed)
+		metrics.MajorPageFaultsPerSecond = utils.CounterRate(stat.majorFaults, prev.majorFaults, elapsed)
+		metrics.PagesScannedPerSecond = utils.CounterRate(stat.scanned, prev.scanned, elapsed)
+		metrics.PagesScannedDirectPerSecond = utils.CounterRate(stat.scannedDirect, prev.scannedDirect, elapsed)
+		metrics.PagesStolenPerSecond = utils.CounterRate(stat.stolen, prev.stolen, elapsed)
+		metrics.OomKillsPerSecond = utils.CounterRate(stat.oomKills, prev.oomKills, elapsed)
+	}
+	s.prev = stat
+
+	return metrics, nil, time.Since(start)
+}
+
+// readMemInfo parses /proc/meminfo into a map keyed by field name.
+// Values with a "kB" unit are converted to bytes; unitless values (e.g., HugePages_Total) are kept as is.
+//
+// Returns:
+//   - map[string]uint64: the parsed fields.
+//   - error: non-nil if the file cannot be read.
+func readMemInfo() (map[string]uint64, error) {
+	file, err := os.Open(utils.HostProc("meminfo"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info := make(map[string]uint64)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, rest, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		if len(fields) > 1 && fields[1] == "kB" {
+			value *= 1024
+		}
+		info[key] = value
+	}
+
+	return info, scanner.Err()
+}
+
+// readVmStat parses the paging and reclaim counters of /proc/vmstat.
+//
+// Reclaim counters are summed over kswapd, direct reclaim and khugepaged, including the
+// per-zone variants of older kernels (e.g., pgscan_kswapd_normal). The pgscan_direct_throttle
+// event counter and the per-LRU pgscan_anon/pgscan_file breakdowns are excluded to avoid
+// double counting.
+//
+// Returns:
+//   - *vmStat: the counters, timestamped with the read time.
+//   - error: non-nil if the file cannot be read.
+func readVmStat() (*vmStat, error) {
+	file, err := os.Open(utils.HostProc("vmstat"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	stat := &vmStat{at: time.Now()}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		key := fields[0]
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case key == "pswpin":
+			stat.swapIn = value
+		case key == "pswpout":
+			stat.swapOut = value
+		case key == "pgmajfault":
+			stat.majorFaults = value
+		case key == "oom_kill":
+			stat.oomKills = value
+		case key == "pgscan_direct_throttle":
+			// Number of throttling events, not pages.
+		case strings.HasPrefix(key, "pgscan_direct"):
+			stat.scanned += value
+			stat.scannedDirect += value
+		case strings.HasPrefix(key, "pgscan_kswapd"), strings.HasPrefix(key, "pgscan_khugepaged"):
+			stat.scanned += value
+		case strings.HasPrefix(key, "pgsteal_kswapd"), strings.HasPrefix(key, "pgsteal_direct"),
+			strings.HasPrefix(key, "pgsteal_khugepaged"):
+			stat.stolen += value
+		}
+	}
+
+	return stat, scanner.Err()
+}
@@ -1,40 +1,167 @@
 package node
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/kubensage/kubensage-agent/pkg/utils"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"github.com/shirou/gopsutil/v3/net"
 )
 
-// buildNetUsage converts a gopsutil net.IOCountersStat into a gen.NetUsage proto message.
+// interfaceUsage holds the counters of one network interface and their rates over a sampling interval.
+type interfaceUsage struct {
+	counters        net.IOCountersStat
+	bytesSentRate   float64
+	bytesRecvRate   float64
+	packetsSentRate float64
+	packetsRecvRate float64
+	errorsRate      float64
+	dropsRate       float64
+}
+
+// NetSampler reads per-interface network counters and derives their per-second rates
+// from the counters of two consecutive samples.
+//
+// Interfaces whose name starts with one of the exclusion prefixes are still sampled and
+// counted in the node NetUsage aggregate, but their counters are left out of the
+// per-interface breakdown.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type NetSampler struct {
+	mu              sync.Mutex
+	excludePrefixes []string
+	prev            map[string]net.IOCountersStat
+	prevAt          time.Time
+}
+
+// NewNetSampler creates a NetSampler primed with the current counters.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the priming read.
+//   - excludePrefixes []string: interface name prefixes whose counters are left out of the per-interface breakdown (e.g., "veth", "cali").
+//
+// Returns:
+//   - *NetSampler: the primed sampler.
+func NewNetSampler(
+	ctx context.Context,
+	excludePrefixes []string,
+) *NetSampler {
+	s := &NetSampler{excludePrefixes: excludePrefixes}
+	_, _, _ = s.Sample(ctx)
+	return s
+}
+
+// Sample reads the counters of every network interface and computes their rates since the previous sample.
+// Rates are zero on the first sample and for interfaces that appeared since the previous one.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the /proc/net/dev read.
+//
+// Returns:
+//   - map[string]interfaceUsage: counters and rates, keyed by interface name.
+//   - error: non-nil if the counters could not be read.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *NetSampler) Sample(
+	ctx context.Context,
+) (map[string]interfaceUsage, error, time.Duration) {
+	start := time.Now()
+
+	stats, err := net.IOCountersWithContext(ctx, true)
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elapsed := now.Sub(s.prevAt)
+	usages := make(map[string]interfaceUsage, len(stats))
+	prev := make(map[string]net.IOCountersStat, len(stats))
+
+	for _, stat := range stats {
+		usage := interfaceUsage{counters: stat}
+
+		if p, ok := s.prev[stat.Name]; ok {
+			usage.bytesSentRate = utils.CounterRate(stat.BytesSent, p.BytesSent, elapsed)
+			usage.bytesRecvRate = utils.CounterRate(stat.BytesRecv, p.BytesRecv, elapsed)
+			usage.packetsSentRate = utils.CounterRate(stat.PacketsSent, p.PacketsSent, elapsed)
+			usage.packetsRecvRate = utils.CounterRate(stat.PacketsRecv, p.PacketsRecv, elapsed)
+			usage.errorsRate = utils.CounterRate(stat.Errin, p.Errin, elapsed) +
+				utils.CounterRate(stat.Errout, p.Errout, elapsed)
+			usage.dropsRate = utils.CounterRate(stat.Dropin, p.Dropin, elapsed) +
+				utils.CounterRate(stat.Dropout, p.Dropout, elapsed)
+		}
+
+		usages[stat.Name] = usage
+		prev[stat.Name] = stat
+	}
+
+	s.prev = prev
+	s.prevAt = now
+
+	return usages, nil, time.Since(start)
+}
+
+// excluded reports whether the counters of the interface are left out of the per-interface breakdown.
 //
-// It extracts cumulative network statistics such as bytes sent/received, packets,
-// errors, and drops. Designed to be used for node-level interface aggregation.
+// Parameters:
+//   - name string: the interface name.
+//
+// Returns:
+//   - bool: true if the name starts with one of the exclusion prefixes.
+func (s *NetSampler) excluded(
+	name string,
+) bool {
+	for _, prefix := range s.excludePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildNetUsage aggregates per-interface counters and rates into a gen.NetUsage proto message.
+//
+// It sums cumulative network statistics such as bytes sent/received, packets,
+// errors, and drops over all interfaces, excluded ones included.
 //
 // Parameters:
-//   - stat: net.IOCountersStat with network counters from gopsutil
+//   - usages: per-interface counters and rates, as returned by NetSampler.Sample
 //
 // Returns:
 //   - *gen.NetUsage containing summarized network metrics
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func buildNetUsage(
-	stat net.IOCountersStat,
+	usages map[string]interfaceUsage,
 ) (*gen.NetUsage, time.Duration) {
 	start := time.Now()
 
-	netUsage := &gen.NetUsage{
-		TotalBytesSent:       stat.BytesSent,
-		TotalBytesReceived:   stat.BytesRecv,
-		TotalPacketsSent:     stat.PacketsSent,
-		TotalPacketsReceived: stat.PacketsRecv,
-		TotalErrIn:           stat.Errin,
-		TotalErrOut:          stat.Errout,
-		TotalDropIn:          stat.Dropin,
-		TotalDropOut:         stat.Dropout,
-		TotalFifoErrIn:       stat.Fifoin,
-		TotalFifoErrOut:      stat.Fifoout,
+	netUsage := &gen.NetUsage{}
+
+	for _, usage := range usages {
+		stat := usage.counters
+		netUsage.TotalBytesSent += stat.BytesSent
+		netUsage.TotalBytesReceived += stat.BytesRecv
+		netUsage.TotalPacketsSent += stat.PacketsSent
+		netUsage.TotalPacketsReceived += stat.PacketsRecv
+		netUsage.TotalErrIn += stat.Errin
+		netUsage.TotalErrOut += stat.Errout
+		netUsage.TotalDropIn += stat.Dropin
+		netUsage.TotalDropOut += stat.Dropout
+		netUsage.TotalFifoErrIn += stat.Fifoin
+		netUsage.TotalFifoErrOut += stat.Fifoout
+
+		netUsage.BytesSentPerSecond += usage.bytesSentRate
+		netUsage.BytesReceivedPerSecond += usage.bytesRecvRate
+		netUsage.PacketsSentPerSecond += usage.packetsSentRate
+		netUsage.PacketsReceivedPerSecond += usage.packetsRecvRate
 	}
 
 	return netUsage, time.Since(start)
@@ -44,17 +171,22 @@ func buildNetUsage(
 //
 // Each interface includes its name, index, MTU, hardware address, flags,
 // and assigned IP addresses. This function helps serialize interface metadata
-// for transmission or storage.
+// for transmission or storage. Interfaces are joined by name to their sampled
+// counters and rates, unless excluded by the sampler, and enriched with the link
+// state found in /sys/class/net.
 //
 // Parameters:
 //   - interfaces: List of network interfaces from gopsutil
-//   - logger: Logger for debug tracing
+//   - usages: per-interface counters and rates, as returned by NetSampler.Sample (may be nil)
+//   - sampler: the sampler holding the exclusion prefixes
 //
 // Returns:
 //   - []*gen.InterfaceStat representing all valid system interfaces
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 func listNetworkInterfaces(
 	interfaces net.InterfaceStatList,
+	usages map[string]interfaceUsage,
+	sampler *NetSampler,
 ) ([]*gen.InterfaceStat, time.Duration) {
 	start := time.Now()
 
@@ -66,19 +198,72 @@ func listNetworkInterfaces(
 			addresses = append(addresses, addr.Addr)
 		}
 
-		networkInterfaces = append(networkInterfaces, &gen.InterfaceStat{
-			Index:        int32(stat.Index),
-			Mtu:          int32(stat.MTU),
-			Name:         stat.Name,
-			HardwareAddr: stat.HardwareAddr,
-			Flags:        stat.Flags,
-			Addrs:        addresses,
-		})
+		iface := &gen.InterfaceStat{
+			Index:            int32(stat.Index),
+			Mtu:              int32(stat.MTU),
+			Name:             stat.Name,
+			HardwareAddr:     stat.HardwareAddr,
+			Flags:            stat.Flags,
+			Addrs:            addresses,
+			CountersExcluded: sampler.excluded(stat.Name),
+		}
+
+		if usage, ok := usages[stat.Name]; ok && !iface.CountersExcluded {
+			iface.BytesSent = usage.counters.BytesSent
+			iface.BytesReceived = usage.counters.BytesRecv
+			iface.PacketsSent = usage.counters.PacketsSent
+			iface.PacketsReceived = usage.counters.PacketsRecv
+			iface.ErrIn = usage.counters.Errin
+			iface.ErrOut = usage.counters.Errout
+			iface.DropIn = usage.counters.Dropin
+			iface.DropOut = usage.counters.Dropout
+			iface.BytesSentPerSecond = usage.bytesSentRate
+			iface.BytesReceivedPerSecond = usage.bytesRecvRate
+			iface.PacketsSentPerSecond = usage.packetsSentRate
+			iface.PacketsReceivedPerSecond = usage.packetsRecvRate
+			iface.ErrorsPerSecond = usage.errorsRate
+			iface.DropsPerSecond = usage.dropsRate
+		}
+
+		readLinkState(iface)
+
+		networkInterfaces = append(networkInterfaces, iface)
 	}
 
 	return networkInterfaces, time.Since(start)
 }
 
+// readLinkState fills the link speed, duplex, operational state and carrier changes of an
+// interface from /sys/class/net/<name>.
+//
+// Attributes that are missing or unreadable (e.g., speed of a virtual or down interface,
+// which the kernel reports as -1 or EINVAL) are left at their zero value.
+//
+// Parameters:
+//   - iface *gen.InterfaceStat: the interface to enrich, identified by its name.
+func readLinkState(
+	iface *gen.InterfaceStat,
+) {
+	dir := utils.HostSys("class", "net", iface.Name)
+
+	readAttr := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(data))
+	}
+
+	if speed, err := strconv.ParseInt(readAttr("speed"), 10, 64); err == nil && speed > 0 {
+		iface.SpeedMbps = speed
+	}
+	iface.Duplex = readAttr("duplex")
+	iface.Operstate = readAttr("operstate")
+	if changes, err := strconv.ParseUint(readAttr("carrier_changes"), 10, 64); err == nil {
+		iface.CarrierChanges = changes
+	}
+}
+
 // buildPrimaryIPs determines the primary IPv4 and IPv6 addresses from a list of interfaces.
 //
 // It skips loopback, virtual, and known non-routable interface prefixes (e.g. `veth`, `cali`, etc.).
@@ -0,0 +1,214 @@
+package node
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// netStackCounters holds the counters of /proc/net/snmp, /proc/net/netstat and
+// /proc/net/sockstat(6), keyed by "<section>.<name>" (e.g., "Tcp.RetransSegs", "TcpExt.ListenOverflows",
+// "TCP.tw", "TCP6.inuse").
+type netStackCounters struct {
+	values map[string]uint64
+	at     time.Time
+}
+
+// NetStackSampler collects TCP and UDP stack health counters of the host network namespace
+// and derives their rates from two consecutive samples.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type NetStackSampler struct {
+	mu   sync.Mutex
+	prev *netStackCounters
+}
+
+// NewNetStackSampler creates a NetStackSampler primed with the current counters.
+//
+// Returns:
+//   - *NetStackSampler: the primed sampler.
+func NewNetStackSampler() *NetStackSampler {
+	s := &NetStackSampler{}
+	if counters, err := readNetStackCounters(); err == nil {
+		s.prev = counters
+	}
+	return s
+}
+
+// Sample reads the network stack counters and builds a NetworkStackMetrics message.
+//
+// Returns:
+//   - *gen.NetworkStackMetrics: cumulative counters, socket gauges and rates since the previous sample.
+//   - error: non-nil if /proc/net/snmp cannot be read. The other files are optional.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *NetStackSampler) Sample() (*gen.NetworkStackMetrics, error, time.Duration) {
+	start := time.Now()
+
+	cur, err := readNetStackCounters()
+	if err != nil {
+		return nil, err, time.Since(start)
+	}
+	v := cur.values
+
+	pageSize := uint64(os.Getpagesize())
+
+	metrics := &gen.NetworkStackMetrics{
+		TcpActiveOpens:      v["Tcp.ActiveOpens"],
+		TcpPassiveOpens:     v["Tcp.PassiveOpens"],
+		TcpAttemptFails:     v["Tcp.AttemptFails"],
+		TcpEstabResets:      v["Tcp.EstabResets"],
+		TcpCurrEstab:        v["Tcp.CurrEstab"],
+		TcpInSegs:           v["Tcp.InSegs"],
+		TcpOutSegs:          v["Tcp.OutSegs"],
+		TcpRetransSegs:      v["Tcp.RetransSegs"],
+		TcpInErrs:           v["Tcp.InErrs"],
+		TcpOutRsts:          v["Tcp.OutRsts"],
+		TcpListenOverflows:  v["TcpExt.ListenOverflows"],
+		TcpListenDrops:      v["TcpExt.ListenDrops"],
+		TcpSyncookiesSent:   v["TcpExt.SyncookiesSent"],
+		TcpSyncookiesRecv:   v["TcpExt.SyncookiesRecv"],
+		TcpSyncookiesFailed: v["TcpExt.SyncookiesFailed"],
+		TcpSocketsInUse:     v["TCP.inuse"] + v["TCP6.inuse"],
+		TcpTimeWait:         v["TCP.tw"],
+		TcpOrphans:          v["TCP.orphan"],
+		TcpAllocated:        v["TCP.alloc"],
+		TcpMemoryBytes:      v["TCP.mem"] * pageSize,
+		UdpInDatagrams:      v["Udp.InDatagrams"],
+		UdpOutDatagrams:     v["Udp.OutDatagrams"],
+		UdpNoPorts:          v["Udp.NoPorts"],
+		UdpInErrors:         v["Udp.InErrors"],
+		UdpRcvbufErrors:     v["Udp.RcvbufErrors"],
+		UdpSndbufErrors:     v["Udp.SndbufErrors"],
+		UdpSocketsInUse:     v["UDP.inuse"] + v["UDP6.inuse"],
+		UdpMemoryBytes:      v["UDP.mem"] * pageSize,
+		SocketsUsed:         v["sockets.used"],
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if prev := s.prev; prev != nil {
+		p := prev.values
+		elapsed := cur.at.Sub(prev.at)
+		rate := func(key string) float64 { return utils.CounterRate(v[key], p[key], elapsed) }
+
+		metrics.TcpActiveOpensPerSecond = rate("Tcp.ActiveOpens")
+		metrics.TcpPassiveOpensPerSecond = rate("Tcp.PassiveOpens")
+		metrics.TcpRetransSegsPerSecond = rate("Tcp.RetransSegs")
+		metrics.TcpOutRstsPerSecond = rate("Tcp.OutRsts")
+		metrics.TcpListenOverflowsPerSecond = rate("TcpExt.ListenOverflows")
+		metrics.TcpListenDropsPerSecond = rate("TcpExt.ListenDrops")
+		metrics.UdpInErrorsPerSecond = rate("Udp.InErrors")
+		metrics.UdpRcvbufErrorsPerSecond = rate("Udp.RcvbufErrors")
+
+		if outSegs := utils.CounterDelta(v["Tcp.OutSegs"], p["Tcp.OutSegs"]); outSegs > 0 {
+			retrans := utils.CounterDelta(v["Tcp.RetransSegs"], p["Tcp.RetransSegs"])
+			metrics.TcpRetransmitPercent = float64(retrans) / float64(outSegs) * 100
+		}
+	}
+	s.prev = cur
+
+	return metrics, nil, time.Since(start)
+}
+
+// readNetStackCounters reads /proc/net/snmp, /proc/net/netstat, /proc/net/sockstat and
+// /proc/net/sockstat6 of the host network namespace.
+//
+// Returns:
+//   - *netStackCounters: the counters of every file, timestamped with the read time.
+//   - error: non-nil if /proc/net/snmp cannot be read. Missing optional files (e.g., sockstat6
+//     without IPv6) are ignored.
+func readNetStackCounters() (*netStackCounters, error) {
+	counters := &netStackCounters{values: make(map[string]uint64), at: time.Now()}
+
+	if err := parseProcNetTable(utils.HostProcNet("snmp"), counters.values); err != nil {
+		return nil, err
+	}
+	_ = parseProcNetTable(utils.HostProcNet("netstat"), counters.values)
+	_ = parseSockstat(utils.HostProcNet("sockstat"), counters.values)
+	_ = parseSockstat(utils.HostProcNet("sockstat6"), counters.values)
+
+	return counters, nil
+}
+
+// parseProcNetTable parses a /proc/net/snmp style file, where each section is made of a header
+// line with the counter names followed by a line with the values, both prefixed by the section name:
+//
+//	Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens ...
+//	Tcp: 1 200 120000 -1 1538 ...
+//
+// Negative values (e.g., MaxConn -1 for "no limit") are skipped.
+func parseProcNetTable(
+	path string,
+	values map[string]uint64,
+) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	var header []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		// A section is a header line immediately followed by its values line.
+		if header == nil || header[0] != fields[0] {
+			header = fields
+			continue
+		}
+
+		section := strings.TrimSuffix(fields[0], ":")
+		for i := 1; i < len(fields) && i < len(header); i++ {
+			if value, err := strconv.ParseUint(fields[i], 10, 64); err == nil {
+				values[section+"."+header[i]] = value
+			}
+		}
+		header = nil
+	}
+
+	return scanner.Err()
+}
+
+// parseSockstat parses a /proc/net/sockstat style file, made of "<section>: <name> <value> ..." lines:
+//
+//	sockets: used 290
+//	TCP: inuse 5 orphan 0 tw 2 alloc 8 mem 1
+func parseSockstat(
+	path string,
+	values map[string]uint64,
+) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+
+		section := strings.TrimSuffix(fields[0], ":")
+		for i := 1; i+1 < len(fields); i += 2 {
+			if value, err := strconv.ParseUint(fields[i+1], 10, 64); err == nil {
+				values[section+"."+fields[i]] = value
+			}
+		}
+	}
+
+	return scanner.Err()
+}
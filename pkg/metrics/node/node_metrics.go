@@ -6,9 +6,10 @@ import (
 	"time"
 
 	"github.com/kubensage/go-common/go"
+	"github.com/kubensage/kubensage-agent/pkg/cli"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"github.com/shirou/gopsutil/v3/cpu"
-	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/host"
 	"github.com/shirou/gopsutil/v3/mem"
 	"github.com/shirou/gopsutil/v3/net"
@@ -24,22 +25,29 @@ import (
 // It gathers information including:
 //
 //   - Host info (OS, kernel, uptime, hostname, etc.)
-//   - CPU info (per-core and total usage)
+//   - CPU info (per-core and total usage, with breakdown by mode)
 //   - Memory usage
-//   - Network usage
-//   - Disk I/O and disk usage
-//   - PSI (Pressure Stall Information) for CPU, memory, and IO
+//   - Network usage, per interface and in aggregate, with rates and link state
+//   - Disk I/O per block device (rates, utilization, latency) and in aggregate
+//   - Disk and inode usage of the selected filesystems, deduplicated by device
+//   - PSI (Pressure Stall Information) for CPU, memory, IO and IRQ, when supported by the kernel
 //   - Network interfaces and primary IP addresses
-//   - Top N memory-consuming processes
+//   - Load average, run queue, context switch, fork and interrupt rates
+//   - Memory breakdown (/proc/meminfo) and paging and reclaim rates (/proc/vmstat)
+//   - TCP and UDP stack health (/proc/net/snmp, netstat, sockstat)
+//   - Conntrack table usage and netfilter pressure, when the module is loaded
+//   - Kernel limits usage: file handles, PIDs, threads, inotify and kubepods pids
+//   - Top N processes by CPU and by memory, attributed to their pod and container
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeout
-//   - interval: Duration for calculating CPU usage percentages
+//   - samplers: Stateful collectors computing values between two collection cycles (e.g., CPU usage)
 //   - logger: Structured logger for debug/info/error messages
-//   - topN: Number of processes to include in top memory usage
+//   - agentCfg: Agent configuration (number of top processes per list, filesystem selection, ...)
 //
 // Returns:
 //   - *gen.NodeMetrics: Complete set of collected node-level metrics
+//   - map[string]time.Duration: Duration of each individual probe, keyed by probe name (e.g., "host_info")
 //   - []error: List of non-fatal errors encountered during metric collection
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
 //
@@ -47,33 +55,33 @@ import (
 // All collectors are safe and tolerant to failures; they append errors instead of panicking.
 func BuildNodeMetrics(
 	ctx context.Context,
-	interval time.Duration,
+	samplers *Samplers,
 	logger *zap.Logger,
-	topN int,
-) (*gen.NodeMetrics, []error, time.Duration) {
+	agentCfg *cli.AgentConfig,
+) (*gen.NodeMetrics, map[string]time.Duration, []error, time.Duration) {
 	start := time.Now()
 
 	// Durations (RPC/system calls)
 	var hostInfoWithContextDuration time.Duration
 	var cpuInfoWithContextDuration time.Duration
-	var cpuPercentWithContextDuration time.Duration
-	var totalCpuPercentWithContextDuration time.Duration
+	var cpuSampleDuration time.Duration
 	var virtualMemoryWithContextDuration time.Duration
 	var netIOCountersWithContextDuration time.Duration
 	var diskIOCountersWithContextDuration time.Duration
 	var diskPartitionsWithContextDuration time.Duration
 	var netInterfacesWithContextDuration time.Duration
+	var loadSampleDuration time.Duration
+	var memorySampleDuration time.Duration
+	var processSampleDuration time.Duration
+	var netStackSampleDuration time.Duration
+	var conntrackSampleDuration time.Duration
+	var limitsSampleDuration time.Duration
+	var psiSampleDuration time.Duration
 
 	// Durations (post-processing/build)
-	var listCpuInfosDuration time.Duration
 	var buildNetUsageDuration time.Duration
 	var buildDiskIOSummaryDuration time.Duration
 	var listDiskUsagesDuration time.Duration
-	var listTopMemDuration time.Duration
-	var listNetworkInterfacesDuration time.Duration
-	var buildCpuPsiMetricsDuration time.Duration
-	var buildMemPsiMetricsDuration time.Duration
-	var buildIOPsiMetricsDuration time.Duration
 
 	var wg sync.WaitGroup
 	var mu sync.Mutex
@@ -97,7 +105,7 @@ func BuildNodeMetrics(
 		hostInfoWithContextDuration = time.Since(start)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("host_info", err))
 		}
 	})
 
@@ -110,36 +118,18 @@ func BuildNodeMetrics(
 		cpuInfoWithContextDuration = time.Since(start)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("cpu_info", err))
 		}
 	})
 
-	var cpuPercents []float64
-	var _cpuInfos []*gen.CpuInfo
+	var totalCpuUsage cpuUsage
+	var cpuUsages map[int32]cpuUsage
 	gogo.SafeGo(&wg, func() {
-		start := time.Now()
-
-		var err error
-		cpuPercents, err = cpu.PercentWithContext(ctx, interval, true)
-		cpuPercentWithContextDuration = time.Since(start)
-
-		if err != nil {
-			addErr(err)
-		} else {
-			_cpuInfos, listCpuInfosDuration = listCpuInfos(cpuInfo, cpuPercents)
-		}
-	})
-
-	var totalCpuPercent []float64
-	gogo.SafeGo(&wg, func() {
-		start := time.Now()
-
 		var err error
-		totalCpuPercent, err = cpu.PercentWithContext(ctx, interval, false)
-		totalCpuPercentWithContextDuration = time.Since(start)
+		totalCpuUsage, cpuUsages, err, cpuSampleDuration = samplers.Cpu.Sample(ctx)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("cpu_times", err))
 		}
 	})
 
@@ -152,71 +142,63 @@ func BuildNodeMetrics(
 		virtualMemoryWithContextDuration = time.Since(start)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("virtual_memory", err))
 		}
 	})
 
-	var netInfoIO []net.IOCountersStat
+	var netUsages map[string]interfaceUsage
 	var _netUsage *gen.NetUsage
 	gogo.SafeGo(&wg, func() {
-		start := time.Now()
-
 		var err error
-		netInfoIO, err = net.IOCountersWithContext(ctx, false)
-		netIOCountersWithContextDuration = time.Since(start)
+		netUsages, err, netIOCountersWithContextDuration = samplers.Net.Sample(ctx)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("net_iocounters", err))
 		} else {
-			_netUsage, buildNetUsageDuration = buildNetUsage(netInfoIO[0])
+			_netUsage, buildNetUsageDuration = buildNetUsage(netUsages)
 		}
 	})
 
-	var counters map[string]disk.IOCountersStat
+	var diskIOUsages map[string]blockDeviceUsage
 	var _diskIoSummary *gen.DiskIOSummary
 	gogo.SafeGo(&wg, func() {
-		start := time.Now()
-
 		var err error
-		counters, err = disk.IOCountersWithContext(ctx)
-		diskIOCountersWithContextDuration = time.Since(start)
+		diskIOUsages, err, diskIOCountersWithContextDuration = samplers.Disk.Sample(ctx)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("disk_iocounters", err))
 		} else {
-			_diskIoSummary, buildDiskIOSummaryDuration = buildDiskIOSummary(counters)
+			_diskIoSummary, buildDiskIOSummaryDuration = buildDiskIOSummary(diskIOUsages)
 		}
 	})
 
-	var partitions []disk.PartitionStat
+	var mounts []mountInfo
 	var _diskUsages []*gen.DiskUsage
 	gogo.SafeGo(&wg, func() {
 		start := time.Now()
 
 		var err error
-		partitions, err = disk.PartitionsWithContext(ctx, true)
+		mounts, err = readMountInfo()
 		diskPartitionsWithContextDuration = time.Since(start)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("disk_partitions", err))
 		} else {
-			_diskUsages, listDiskUsagesDuration = listDiskUsages(partitions)
+			_diskUsages, listDiskUsagesDuration = listDiskUsages(mounts, agentCfg)
 		}
 	})
 
-	var processesMemInfo []*gen.ProcessMemInfo
+	var topCpuProcesses, topMemoryProcesses []*gen.ProcessStats
 	gogo.SafeGo(&wg, func() {
 		var err error
-		processesMemInfo, err, listTopMemDuration = listTopMem(ctx, topN)
+		topCpuProcesses, topMemoryProcesses, err, processSampleDuration = samplers.Process.Sample(ctx, agentCfg.TopN)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("processes", err))
 		}
 	})
 
 	var interfaces []net.InterfaceStat
-	var _networkInterfaces []*gen.InterfaceStat
-	var ipv4, ipv6 string
 	gogo.SafeGo(&wg, func() {
 		start := time.Now()
 
@@ -225,28 +207,77 @@ func BuildNodeMetrics(
 		netInterfacesWithContextDuration = time.Since(start)
 
 		if err != nil {
-			addErr(err)
+			addErr(utils.NewCollectorError("net_interfaces", err))
+		}
+	})
+
+	var pressure nodePressure
+	gogo.SafeGo(&wg, func() {
+		var err error
+		pressure, err, psiSampleDuration = samplers.Psi.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("psi", err))
+		}
+	})
+
+	var loadMetrics *gen.LoadMetrics
+	gogo.SafeGo(&wg, func() {
+		var err error
+		loadMetrics, err, loadSampleDuration = samplers.Load.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("load", err))
 		}
+	})
 
-		_networkInterfaces, listNetworkInterfacesDuration = listNetworkInterfaces(interfaces)
-		ipv4, ipv6 = buildPrimaryIPs(_networkInterfaces)
+	var nodeMemoryMetrics *gen.NodeMemoryMetrics
+	gogo.SafeGo(&wg, func() {
+		var err error
+		nodeMemoryMetrics, err, memorySampleDuration = samplers.Memory.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("memory", err))
+		}
 	})
 
-	var cpuPsi, memPsi, ioPsi *gen.PsiMetrics
+	var networkStackMetrics *gen.NetworkStackMetrics
 	gogo.SafeGo(&wg, func() {
-		cpuPsi, buildCpuPsiMetricsDuration = buildPsiMetrics("/proc/pressure/cpu", logger)
+		var err error
+		networkStackMetrics, err, netStackSampleDuration = samplers.NetStack.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("net_stack", err))
+		}
 	})
 
+	var conntrackMetrics *gen.ConntrackMetrics
 	gogo.SafeGo(&wg, func() {
-		memPsi, buildMemPsiMetricsDuration = buildPsiMetrics("/proc/pressure/memory", logger)
+		var err error
+		conntrackMetrics, err, conntrackSampleDuration = samplers.Conntrack.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("conntrack", err))
+		}
 	})
 
+	var kernelLimits *gen.KernelLimits
 	gogo.SafeGo(&wg, func() {
-		ioPsi, buildIOPsiMetricsDuration = buildPsiMetrics("/proc/pressure/io", logger)
+		var err error
+		kernelLimits, err, limitsSampleDuration = samplers.Limits.Sample()
+
+		if err != nil {
+			addErr(utils.NewCollectorError("kernel_limits", err))
+		}
 	})
 
 	wg.Wait()
 
+	_cpuInfos, listCpuInfosDuration := listCpuInfos(cpuInfo, cpuUsages)
+	_networkInterfaces, listNetworkInterfacesDuration := listNetworkInterfaces(interfaces, netUsages, samplers.Net)
+	ipv4, ipv6 := buildPrimaryIPs(_networkInterfaces)
+	_diskIOStats, listDiskIOStatsDuration := listDiskIOStats(diskIOUsages, _diskUsages)
+
 	nodeInfo := &gen.NodeMetrics{
 		Hostname: info.Hostname,
 		Uptime:   info.Uptime,
@@ -261,7 +292,8 @@ func BuildNodeMetrics(
 		KernelArch:      info.KernelArch,
 		HostId:          info.HostID,
 
-		TotalCpuPercentage: totalCpuPercent[0],
+		TotalCpuPercentage: totalCpuUsage.usage,
+		TotalCpuTimes:      totalCpuUsage.times,
 		CpuInfos:           _cpuInfos,
 
 		TotalMemory:     memInfo.Total,
@@ -271,16 +303,31 @@ func BuildNodeMetrics(
 
 		NetUsage: _netUsage,
 
-		ProcessesMemInfo: processesMemInfo,
+		ProcessesMemInfo:   buildProcessesMemInfo(topMemoryProcesses),
+		TopCpuProcesses:    topCpuProcesses,
+		TopMemoryProcesses: topMemoryProcesses,
 
 		DiskUsages:    _diskUsages,
 		DiskIoSummary: _diskIoSummary,
+		DiskIoStats:   _diskIOStats,
 
-		PsiCpuMetrics:    cpuPsi,
-		PsiMemoryMetrics: memPsi,
-		PsiIoMetrics:     ioPsi,
+		PsiCpuMetrics:    pressure.cpu,
+		PsiMemoryMetrics: pressure.memory,
+		PsiIoMetrics:     pressure.io,
+		PsiIrqMetrics:    pressure.irq,
+		PsiSupported:     samplers.Psi.Supported(),
 
 		NetworkInterfaces: _networkInterfaces,
+
+		LoadMetrics: loadMetrics,
+
+		MemoryMetrics: nodeMemoryMetrics,
+
+		NetworkStackMetrics: networkStackMetrics,
+
+		ConntrackMetrics: conntrackMetrics,
+
+		KernelLimits: kernelLimits,
 	}
 
 	if ipv4 != "" {
@@ -292,29 +339,57 @@ func BuildNodeMetrics(
 
 	total := time.Since(start)
 
+	probeDurations := map[string]time.Duration{
+		"host_info":       hostInfoWithContextDuration,
+		"cpu_info":        cpuInfoWithContextDuration,
+		"cpu_times":       cpuSampleDuration,
+		"virtual_memory":  virtualMemoryWithContextDuration,
+		"net_iocounters":  netIOCountersWithContextDuration,
+		"disk_iocounters": diskIOCountersWithContextDuration,
+		"disk_partitions": diskPartitionsWithContextDuration,
+		"net_interfaces":  netInterfacesWithContextDuration,
+		"load":            loadSampleDuration,
+		"memory":          memorySampleDuration,
+		"processes":       processSampleDuration,
+		"net_stack":       netStackSampleDuration,
+		"conntrack":       conntrackSampleDuration,
+		"kernel_limits":   limitsSampleDuration,
+		"psi":             psiSampleDuration,
+
+		"list_cpu_infos":          listCpuInfosDuration,
+		"build_net_usage":         buildNetUsageDuration,
+		"build_disk_io_summary":   buildDiskIOSummaryDuration,
+		"list_disk_usages":        listDiskUsagesDuration,
+		"list_disk_io_stats":      listDiskIOStatsDuration,
+		"list_network_interfaces": listNetworkInterfacesDuration,
+	}
+
 	logger.Debug("node metrics durations",
 		zap.Duration("host_info", hostInfoWithContextDuration),
 		zap.Duration("cpu_info", cpuInfoWithContextDuration),
-		zap.Duration("cpu_percent_percpu", cpuPercentWithContextDuration),
-		zap.Duration("cpu_percent_total", totalCpuPercentWithContextDuration),
+		zap.Duration("cpu_times", cpuSampleDuration),
 		zap.Duration("virtual_memory", virtualMemoryWithContextDuration),
 		zap.Duration("net_iocounters", netIOCountersWithContextDuration),
 		zap.Duration("disk_iocounters", diskIOCountersWithContextDuration),
 		zap.Duration("disk_partitions", diskPartitionsWithContextDuration),
 		zap.Duration("net_interfaces", netInterfacesWithContextDuration),
+		zap.Duration("load", loadSampleDuration),
+		zap.Duration("memory", memorySampleDuration),
+		zap.Duration("processes", processSampleDuration),
+		zap.Duration("net_stack", netStackSampleDuration),
+		zap.Duration("conntrack", conntrackSampleDuration),
+		zap.Duration("kernel_limits", limitsSampleDuration),
+		zap.Duration("psi", psiSampleDuration),
 
 		zap.Duration("list_cpu_infos", listCpuInfosDuration),
 		zap.Duration("build_net_usage", buildNetUsageDuration),
 		zap.Duration("build_disk_io_summary", buildDiskIOSummaryDuration),
 		zap.Duration("list_disk_usages", listDiskUsagesDuration),
-		zap.Duration("list_top_mem", listTopMemDuration),
+		zap.Duration("list_disk_io_stats", listDiskIOStatsDuration),
 		zap.Duration("list_network_interfaces", listNetworkInterfacesDuration),
-		zap.Duration("build_cpu_psi", buildCpuPsiMetricsDuration),
-		zap.Duration("build_mem_psi", buildMemPsiMetricsDuration),
-		zap.Duration("build_io_psi", buildIOPsiMetricsDuration),
 
 		zap.Duration("total", total),
 	)
 
-	return nodeInfo, errs, time.Since(start)
+	return nodeInfo, probeDurations, errs, time.Since(start)
 }
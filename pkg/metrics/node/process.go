@@ -0,0 +1,406 @@
+package node
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"github.com/shirou/gopsutil/v3/host"
+)
+
+const (
+	// userHZ is the unit of the CPU times in /proc/<pid>/stat. It is fixed to 100 on every
+	// Linux architecture supported by Kubernetes, whatever the kernel tick rate.
+	userHZ = 100
+
+	// maxCmdlineLength bounds the length of the reported command lines.
+	maxCmdlineLength = 512
+)
+
+// processKey identifies a process across samples. The start time tells apart two processes
+// that got the same PID.
+type processKey struct {
+	pid   int32
+	start uint64
+}
+
+// processStat holds the fields of /proc/<pid>/stat used by the process sampler.
+type processStat struct {
+	key      processKey
+	ppid     int32
+	name     string
+	state    string
+	cpuTicks uint64 // utime + stime, in userHZ ticks
+	threads  uint32
+	vms      uint64
+	rss      uint64
+	cpu      float64 // CPU usage over the sampling interval, in percent of one CPU
+}
+
+// processIO holds the storage I/O counters of /proc/<pid>/io.
+type processIO struct {
+	readBytes  uint64
+	writeBytes uint64
+}
+
+// ProcessSampler reports the top processes of the node by CPU and by memory.
+//
+// CPU usage is computed from the CPU time of each process between two consecutive samples.
+// Only the selected processes are inspected in depth (command line, user, file descriptors,
+// I/O, cgroup), which keeps the cost of a sample proportional to the number of processes
+// rather than to the number of files read per process.
+//
+// All methods are safe for concurrent use by multiple goroutines.
+type ProcessSampler struct {
+	mu       sync.Mutex
+	bootTime uint64
+	prev     map[processKey]uint64
+	prevIO   map[processKey]processIO
+	prevAt   time.Time
+	users    map[uint32]string
+}
+
+// NewProcessSampler creates a ProcessSampler primed with the current CPU times of every process.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the priming reads.
+//
+// Returns:
+//   - *ProcessSampler: the primed sampler.
+func NewProcessSampler(
+	ctx context.Context,
+) *ProcessSampler {
+	s := &ProcessSampler{users: make(map[uint32]string)}
+	s.bootTime, _ = host.BootTimeWithContext(ctx)
+	_, _, _, _ = s.Sample(ctx, 0)
+	return s
+}
+
+// Sample reads every process of the node and returns the top N by CPU usage and by RSS.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the scan.
+//   - topN int: number of processes to return in each list.
+//
+// Returns:
+//   - []*gen.ProcessStats: the top N processes by CPU usage over the interval since the previous sample.
+//   - []*gen.ProcessStats: the top N processes by resident memory.
+//   - error: non-nil if the process list cannot be read or the context is cancelled.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func (s *ProcessSampler) Sample(
+	ctx context.Context,
+	topN int,
+) ([]*gen.ProcessStats, []*gen.ProcessStats, error, time.Duration) {
+	start := time.Now()
+
+	entries, err := os.ReadDir(utils.HostProc())
+	if err != nil {
+		return nil, nil, err, time.Since(start)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(s.prevAt)
+
+	stats := make([]*processStat, 0, len(entries))
+	cur := make(map[processKey]uint64, len(entries))
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err, time.Since(start)
+		}
+
+		pid, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil {
+			continue // Not a process directory
+		}
+
+		stat, err := readProcessStat(int32(pid))
+		if err != nil {
+			continue // Process exited while scanning
+		}
+
+		if prevTicks, ok := s.prev[stat.key]; ok && elapsed > 0 {
+			stat.cpu = float64(utils.CounterDelta(stat.cpuTicks, prevTicks)) / userHZ / elapsed.Seconds() * 100
+		}
+
+		cur[stat.key] = stat.cpuTicks
+		stats = append(stats, stat)
+	}
+
+	selected := make(map[processKey]*gen.ProcessStats)
+	curIO := make(map[processKey]processIO)
+
+	describe := func(stat *processStat) *gen.ProcessStats {
+		if p, ok := selected[stat.key]; ok {
+			return p
+		}
+		p := s.describeProcess(stat, elapsed, curIO)
+		selected[stat.key] = p
+		return p
+	}
+
+	topCpu := make([]*gen.ProcessStats, 0, min(topN, len(stats)))
+	sort.Slice(stats, func(i, j int) bool { return stats[i].cpu > stats[j].cpu })
+	for _, stat := range stats[:min(topN, len(stats))] {
+		topCpu = append(topCpu, describe(stat))
+	}
+
+	topMem := make([]*gen.ProcessStats, 0, min(topN, len(stats)))
+	sort.Slice(stats, func(i, j int) bool { return stats[i].rss > stats[j].rss })
+	for _, stat := range stats[:min(topN, len(stats))] {
+		topMem = append(topMem, describe(stat))
+	}
+
+	s.prev = cur
+	s.prevIO = curIO
+	s.prevAt = now
+
+	return topCpu, topMem, nil, time.Since(start)
+}
+
+// describeProcess inspects a selected process in depth and builds its ProcessStats message.
+//
+// Attributes that cannot be read (e.g., file descriptors or I/O of processes owned by another
+// user when the agent is not privileged) are left at their zero value. The I/O counters read
+// are stored in curIO, so that rates can be computed if the process is selected again.
+//
+// Must be called with s.mu held.
+func (s *ProcessSampler) describeProcess(
+	stat *processStat,
+	elapsed time.Duration,
+	curIO map[processKey]processIO,
+) *gen.ProcessStats {
+	pid := stat.key.pid
+	dir := utils.HostProc(strconv.Itoa(int(pid)))
+
+	p := &gen.ProcessStats{
+		Pid:            pid,
+		Ppid:           stat.ppid,
+		Name:           strings.ToValidUTF8(stat.name, "�"),
+		Cmdline:        readCmdline(dir),
+		State:          stat.state,
+		CreateTime:     int64(s.bootTime)*1000 + int64(stat.key.start)*1000/userHZ,
+		RssBytes:       stat.rss,
+		VmsBytes:       stat.vms,
+		CpuTimeSeconds: float64(stat.cpuTicks) / userHZ,
+		CpuPercent:     stat.cpu,
+		Threads:        stat.threads,
+	}
+
+	if uid, err := readProcessUid(dir); err == nil {
+		p.Uid = uid
+		p.User = s.lookupUser(uid)
+	}
+
+	if fd, err := os.Open(dir + "/fd"); err == nil {
+		names, _ := fd.Readdirnames(-1)
+		p.Fds = uint32(len(names))
+		_ = fd.Close()
+	}
+
+	if io, err := readProcessIO(dir); err == nil {
+		p.ReadBytes = io.readBytes
+		p.WriteBytes = io.writeBytes
+		if prev, ok := s.prevIO[stat.key]; ok {
+			p.ReadBytesPerSecond = utils.CounterRate(io.readBytes, prev.readBytes, elapsed)
+			p.WriteBytesPerSecond = utils.CounterRate(io.writeBytes, prev.writeBytes, elapsed)
+		}
+		curIO[stat.key] = io
+	}
+
+	if path, err := cgroup.ProcessCgroup(pid); err == nil {
+		ref := cgroup.ParseRef(path)
+		p.Cgroup = strings.ToValidUTF8(path, "�")
+		p.PodUid = ref.PodUID
+		p.ContainerId = ref.ContainerID
+	}
+
+	return p
+}
+
+// lookupUser resolves a user ID to a user name, caching the result (including failures).
+//
+// Must be called with s.mu held.
+func (s *ProcessSampler) lookupUser(
+	uid uint32,
+) string {
+	if name, ok := s.users[uid]; ok {
+		return name
+	}
+
+	var name string
+	if u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10)); err == nil {
+		name = u.Username
+	}
+	s.users[uid] = name
+	return name
+}
+
+// readProcessStat parses /proc/<pid>/stat.
+//
+// The process name is enclosed in parentheses and may itself contain spaces or parentheses,
+// so the fields are split after the last closing parenthesis.
+//
+// Parameters:
+//   - pid int32: the process ID.
+//
+// Returns:
+//   - *processStat: the parsed fields, without CPU usage.
+//   - error: non-nil if the file cannot be read or has an unexpected format.
+func readProcessStat(
+	pid int32,
+) (*processStat, error) {
+	path := utils.HostProc(strconv.Itoa(int(pid)), "stat")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content := string(data)
+	open := strings.IndexByte(content, '(')
+	closing := strings.LastIndexByte(content, ')')
+	if open < 0 || closing < open {
+		return nil, fmt.Errorf("unexpected format of %s", path)
+	}
+
+	// Fields after the name, starting with field 3 (state) of proc(5).
+	fields := strings.Fields(content[closing+1:])
+	if len(fields) < 22 {
+		return nil, fmt.Errorf("unexpected format of %s", path)
+	}
+
+	field := func(n int) uint64 {
+		v, _ := strconv.ParseUint(fields[n-3], 10, 64)
+		return v
+	}
+
+	return &processStat{
+		key:      processKey{pid: pid, start: field(22)},
+		ppid:     int32(field(4)),
+		name:     content[open+1 : closing],
+		state:    fields[0],
+		cpuTicks: field(14) + field(15),
+		threads:  uint32(field(20)),
+		vms:      field(23),
+		rss:      field(24) * uint64(os.Getpagesize()),
+	}, nil
+}
+
+// readCmdline reads the command line of a process, joining its arguments with spaces and
+// truncating it to maxCmdlineLength bytes. Kernel threads have an empty command line.
+func readCmdline(
+	dir string,
+) string {
+	data, err := os.ReadFile(dir + "/cmdline")
+	if err != nil {
+		return ""
+	}
+
+	data = []byte(strings.TrimRight(string(data), "\x00"))
+	if len(data) > maxCmdlineLength {
+		data = data[:maxCmdlineLength]
+	}
+	cmdline := strings.ReplaceAll(string(data), "\x00", " ")
+
+	return strings.ToValidUTF8(cmdline, "�")
+}
+
+// readProcessUid reads the real user ID of a process from /proc/<pid>/status.
+func readProcessUid(
+	dir string,
+) (uint32, error) {
+	file, err := os.Open(dir + "/status")
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// Uid:	<real>	<effective>	<saved>	<filesystem>
+		if rest, ok := strings.CutPrefix(scanner.Text(), "Uid:"); ok {
+			fields := strings.Fields(rest)
+			if len(fields) == 0 {
+				break
+			}
+			uid, err := strconv.ParseUint(fields[0], 10, 32)
+			return uint32(uid), err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return 0, fmt.Errorf("no Uid line in %s/status", dir)
+}
+
+// readProcessIO reads the storage I/O counters of a process from /proc/<pid>/io.
+// Reading another user's process requires CAP_SYS_PTRACE.
+func readProcessIO(
+	dir string,
+) (processIO, error) {
+	file, err := os.Open(dir + "/io")
+	if err != nil {
+		return processIO{}, err
+	}
+	defer func() { _ = file.Close() }()
+
+	var io processIO
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+
+		v, err := strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		switch key {
+		case "read_bytes":
+			io.readBytes = v
+		case "write_bytes":
+			io.writeBytes = v
+		}
+	}
+
+	return io, scanner.Err()
+}
+
+// buildProcessesMemInfo converts the top processes by memory into the legacy ProcessMemInfo messages.
+//
+// Parameters:
+//   - processes []*gen.ProcessStats: the top processes by RSS, as returned by ProcessSampler.Sample.
+//
+// Returns:
+//   - []*gen.ProcessMemInfo: one entry per process, in the same order.
+func buildProcessesMemInfo(
+	processes []*gen.ProcessStats,
+) []*gen.ProcessMemInfo {
+	processesMemInfo := make([]*gen.ProcessMemInfo, 0, len(processes))
+	for _, p := range processes {
+		processesMemInfo = append(processesMemInfo, &gen.ProcessMemInfo{
+			Pid:    p.Pid,
+			Name:   p.Name,
+			Memory: p.RssBytes,
+		})
+	}
+	return processesMemInfo
+}
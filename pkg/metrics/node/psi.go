@@ -1,96 +1,170 @@
 package node
 
 import (
-	"bufio"
-	"os"
-	"strconv"
-	"strings"
+	"sync/atomic"
 	"time"
 
+	"github.com/kubensage/kubensage-agent/pkg/cgroup"
+	"github.com/kubensage/kubensage-agent/pkg/psi"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
 	"github.com/kubensage/kubensage-agent/proto/gen"
-	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-// buildPsiMetrics reads Pressure Stall Information (PSI) metrics from a given /proc file.
+// nodePressure holds the node-wide pressure stall information.
+type nodePressure struct {
+	cpu    *gen.PsiMetrics
+	memory *gen.PsiMetrics
+	io     *gen.PsiMetrics
+	irq    *gen.PsiMetrics // Nil when the kernel does not account IRQ pressure
+}
+
+// PsiSampler reads the Pressure Stall Information (PSI) of the node (/proc/pressure) and of
+// the kubelet cgroups (QoS tiers, pods and containers).
 //
-// PSI metrics provide visibility into resource pressure for CPU, memory, and I/O.
-// The file is expected to follow the format of /proc/pressure/{cpu|memory|io}.
-// This function parses `some` and `full` stall entries, extracting avg10, avg60,
-// avg300, and total values into a gen.PsiMetrics protobuf message.
+// Node-wide support is detected once, at creation: on kernels without PSI (older than 4.20, or
+// booted with psi=0) the reads are skipped instead of failing on every collection cycle. Cgroup
+// support also requires the kubepods cgroup, which does not exist yet when the agent starts
+// before kubelet: it is detected again by SampleKubepods until it succeeds.
 //
-// If the file cannot be read or parsed, a zero-valued PsiMetrics struct is returned.
+// All methods are safe for concurrent use by multiple goroutines.
+type PsiSampler struct {
+	supported       bool
+	irqSupported    bool
+	cgroupSupported atomic.Bool
+}
+
+// NewPsiSampler creates a PsiSampler and detects which PSI files the host exposes.
 //
-// Parameters:
-//   - path: Absolute path to the PSI file (e.g., "/proc/pressure/cpu")
-//   - logger: Logger used for debug and error tracing
+// Returns:
+//   - *PsiSampler: the sampler.
+func NewPsiSampler() *PsiSampler {
+	s := &PsiSampler{}
+
+	if _, err := psi.Read(utils.HostProc("pressure", "cpu")); err == nil {
+		s.supported = true
+		_, err = psi.Read(utils.HostProc("pressure", "irq"))
+		s.irqSupported = err == nil
+	}
+
+	s.detectCgroupSupport()
+
+	return s
+}
+
+// detectCgroupSupport checks whether the kubepods cgroup exposes PSI and records the result.
+// Per-cgroup pressure files only exist on the unified hierarchy.
+func (s *PsiSampler) detectCgroupSupport() {
+	if !s.supported || !cgroup.IsV2() {
+		return
+	}
+	if dir, ok := cgroup.KubepodsPath(""); ok {
+		if _, err := psi.ReadCgroup(dir); err == nil {
+			s.cgroupSupported.Store(true)
+		}
+	}
+}
+
+// Supported reports whether the kernel exposes node-wide PSI.
 //
 // Returns:
-//   - *gen.PsiMetrics containing the parsed stall data
+//   - bool: true if /proc/pressure is readable.
+func (s *PsiSampler) Supported() bool {
+	return s.supported
+}
+
+// CgroupSupported reports whether the kubelet cgroups expose PSI (cgroup v2 with PSI enabled).
+//
+// Returns:
+//   - bool: true if the pressure of the QoS tiers, pods and containers can be read.
+func (s *PsiSampler) CgroupSupported() bool {
+	return s.cgroupSupported.Load()
+}
+
+// Sample reads the node-wide pressure of cpu, memory, io and, when accounted, irq.
+//
+// Returns:
+//   - nodePressure: the pressure per resource, all nil when PSI is not supported.
+//   - error: non-nil if a supported PSI file cannot be read.
 //   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
-func buildPsiMetrics(
-	path string,
-	logger *zap.Logger,
-) (*gen.PsiMetrics, time.Duration) {
+func (s *PsiSampler) Sample() (nodePressure, error, time.Duration) {
 	start := time.Now()
 
-	file, err := os.Open(path)
-	if err != nil {
-		logger.Error("failed to open metrics file", zap.String("path", path), zap.Error(err))
-		return &gen.PsiMetrics{}, time.Since(start)
+	var pressure nodePressure
+	if !s.supported {
+		return pressure, nil, time.Since(start)
+	}
+
+	var err error
+	if pressure.cpu, err = psi.Read(utils.HostProc("pressure", "cpu")); err != nil {
+		return nodePressure{}, err, time.Since(start)
+	}
+	if pressure.memory, err = psi.Read(utils.HostProc("pressure", "memory")); err != nil {
+		return nodePressure{}, err, time.Since(start)
+	}
+	if pressure.io, err = psi.Read(utils.HostProc("pressure", "io")); err != nil {
+		return nodePressure{}, err, time.Since(start)
 	}
-	defer func(file *os.File) {
-		if err := file.Close(); err != nil {
-			logger.Error("error closing PSI file", zap.String("path", path), zap.Error(err))
+	if s.irqSupported {
+		if pressure.irq, err = psi.Read(utils.HostProc("pressure", "irq")); err != nil {
+			return nodePressure{}, err, time.Since(start)
 		}
-	}(file)
+	}
 
-	scanner := bufio.NewScanner(file)
-	var metrics gen.PsiMetrics
+	return pressure, nil, time.Since(start)
+}
 
-	for scanner.Scan() {
-		line := scanner.Text()
-		parts := strings.Fields(line)
+// SampleKubepods reads the pressure of the kubelet QoS tiers, pods and containers.
+//
+// Cgroups that disappear between the scan and the read (e.g., a container that just exited)
+// are skipped.
+//
+// Parameters:
+//   - kubepods *cgroup.Kubepods: the kubelet cgroups, as returned by cgroup.ScanKubepods (may be nil).
+//
+// Returns:
+//   - map[string]*gen.CgroupPressure: pressure per QoS tier ("kubepods", "burstable", "besteffort").
+//   - map[string]*gen.CgroupPressure: pressure per pod UID.
+//   - map[string]*gen.CgroupPressure: pressure per container ID.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+//
+// All maps are nil when per-cgroup PSI is not supported or kubepods is nil.
+func (s *PsiSampler) SampleKubepods(
+	kubepods *cgroup.Kubepods,
+) (
+	map[string]*gen.CgroupPressure,
+	map[string]*gen.CgroupPressure,
+	map[string]*gen.CgroupPressure,
+	time.Duration,
+) {
+	start := time.Now()
 
-		if len(parts) < 5 {
-			continue
+	if kubepods == nil {
+		return nil, nil, nil, time.Since(start)
+	}
+	if !s.cgroupSupported.Load() {
+		// The kubepods cgroup may have been created by kubelet since the last detection.
+		s.detectCgroupSupport()
+		if !s.cgroupSupported.Load() {
+			return nil, nil, nil, time.Since(start)
 		}
+	}
 
-		entry := &gen.PsiData{}
-
-		for _, part := range parts[1:] {
-			kv := strings.Split(part, "=")
-			if len(kv) != 2 {
-				continue
-			}
-
-			switch kv[0] {
-			case "avg10":
-				if val, err := strconv.ParseFloat(kv[1], 64); err == nil {
-					entry.Avg10 = wrapperspb.Double(val)
-				}
-			case "avg60":
-				if val, err := strconv.ParseFloat(kv[1], 64); err == nil {
-					entry.Avg60 = wrapperspb.Double(val)
-				}
-			case "avg300":
-				if val, err := strconv.ParseFloat(kv[1], 64); err == nil {
-					entry.Avg300 = wrapperspb.Double(val)
-				}
-			case "total":
-				if val, err := strconv.ParseUint(kv[1], 10, 64); err == nil {
-					entry.Total = wrapperspb.UInt64(val)
-				}
-			}
-		}
+	qos := readCgroupPressures(kubepods.QoS)
+	pods := readCgroupPressures(kubepods.Pods)
+	containers := readCgroupPressures(kubepods.Containers)
 
-		switch parts[0] {
-		case "some":
-			metrics.Some = entry
-		case "full":
-			metrics.Full = entry
+	return qos, pods, containers, time.Since(start)
+}
+
+// readCgroupPressures reads the pressure of each cgroup, skipping those that cannot be read.
+func readCgroupPressures(
+	paths map[string]string,
+) map[string]*gen.CgroupPressure {
+	pressures := make(map[string]*gen.CgroupPressure, len(paths))
+	for key, path := range paths {
+		if pressure, err := psi.ReadCgroup(cgroup.ControllerPath("", path)); err == nil {
+			pressures[key] = pressure
 		}
 	}
-
-	return &metrics, time.Since(start)
+	return pressures
 }
@@ -0,0 +1,50 @@
+package node
+
+import (
+	"context"
+
+	"github.com/kubensage/kubensage-agent/pkg/cli"
+)
+
+// Samplers groups the node collectors that keep state between collection cycles,
+// so that counters can be turned into values over the actual tick interval.
+//
+// A single Samplers is created at startup and passed to every BuildNodeMetrics call.
+type Samplers struct {
+	Cpu       *CpuSampler       // Per-CPU and total usage with breakdown by mode
+	Load      *LoadSampler      // Load average and scheduler counter rates
+	Memory    *MemorySampler    // Memory breakdown and paging rates
+	Net       *NetSampler       // Per-interface network counter rates
+	Disk      *DiskSampler      // Per-block-device I/O rates, utilization and latency
+	Process   *ProcessSampler   // Top processes by CPU and memory
+	NetStack  *NetStackSampler  // TCP and UDP stack counter rates
+	Conntrack *ConntrackSampler // Conntrack table usage and netfilter counter rates
+	Limits    *LimitsSampler    // Kernel limits usage, with a periodic inotify scan
+	Psi       *PsiSampler       // Node and kubelet cgroups pressure, with support detected once
+}
+
+// NewSamplers creates and primes every node sampler.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the priming reads.
+//   - agentCfg *cli.AgentConfig: agent configuration (e.g., interfaces excluded from the network aggregate).
+//
+// Returns:
+//   - *Samplers: the samplers, ready for the first collection cycle.
+func NewSamplers(
+	ctx context.Context,
+	agentCfg *cli.AgentConfig,
+) *Samplers {
+	return &Samplers{
+		Cpu:       NewCpuSampler(ctx),
+		Load:      NewLoadSampler(),
+		Memory:    NewMemorySampler(),
+		Net:       NewNetSampler(ctx, agentCfg.NetExcludePrefixes),
+		Disk:      NewDiskSampler(ctx),
+		Process:   NewProcessSampler(ctx),
+		NetStack:  NewNetStackSampler(),
+		Conntrack: NewConntrackSampler(),
+		Limits:    NewLimitsSampler(),
+		Psi:       NewPsiSampler(),
+	}
+}
@@ -0,0 +1,79 @@
+package pod
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/kubensage/kubensage-agent/proto/gen"
+)
+
+// appLabels lists the labels holding the application name of a pod, by priority.
+var appLabels = []string{"app.kubernetes.io/name", "app", "k8s-app"}
+
+// deriveOwner derives the workload owning a pod from its name and the labels set by the
+// built-in controllers, as the owner references are not exposed by the CRI.
+//
+//   - Job: the "batch.kubernetes.io/job-name" (or legacy "job-name") label.
+//   - StatefulSet: the "statefulset.kubernetes.io/pod-name" label, the pod name being "<name>-<ordinal>".
+//   - Deployment: the "pod-template-hash" label, the pod name being "<name>-<hash>-<suffix>".
+//   - DaemonSet: the "controller-revision-hash" and "pod-template-generation" labels, the pod
+//     name being "<name>-<suffix>".
+//
+// Parameters:
+//   - name string: the name of the pod.
+//   - labels map[string]string: the unfiltered labels of the pod sandbox.
+//
+// Returns:
+//   - *gen.WorkloadOwner: the owner, or nil if it cannot be derived.
+func deriveOwner(
+	name string,
+	labels map[string]string,
+) *gen.WorkloadOwner {
+	for _, key := range []string{"batch.kubernetes.io/job-name", "job-name"} {
+		if job := labels[key]; job != "" {
+			return &gen.WorkloadOwner{Kind: "Job", Name: job}
+		}
+	}
+
+	if _, ok := labels["statefulset.kubernetes.io/pod-name"]; ok {
+		if i := strings.LastIndexByte(name, '-'); i > 0 {
+			if _, err := strconv.Atoi(name[i+1:]); err == nil {
+				return &gen.WorkloadOwner{Kind: "StatefulSet", Name: name[:i]}
+			}
+		}
+	}
+
+	if hash := labels["pod-template-hash"]; hash != "" {
+		if i := strings.LastIndex(name, "-"+hash+"-"); i > 0 {
+			return &gen.WorkloadOwner{Kind: "Deployment", Name: name[:i]}
+		}
+	}
+
+	_, hasRevision := labels["controller-revision-hash"]
+	_, hasGeneration := labels["pod-template-generation"]
+	if hasRevision && hasGeneration {
+		if i := strings.LastIndexByte(name, '-'); i > 0 {
+			return &gen.WorkloadOwner{Kind: "DaemonSet", Name: name[:i]}
+		}
+	}
+
+	return nil
+}
+
+// deriveApp returns the application name of a pod from the first set label of appLabels.
+//
+// Parameters:
+//   - labels map[string]string: the unfiltered labels of the pod sandbox.
+//
+// Returns:
+//   - string: the application name, or "" if none of the labels is set.
+func deriveApp(
+	labels map[string]string,
+) string {
+	for _, key := range appLabels {
+		if app := labels[key]; app != "" {
+			return app
+		}
+	}
+	return ""
+}
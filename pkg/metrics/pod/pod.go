@@ -2,9 +2,12 @@ package pod
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
 
@@ -46,3 +49,38 @@ func ListPods(
 
 	return resp.Items, nil, time.Since(start)
 }
+
+// ErrSandboxStatsUnimplemented is returned by ListPodSandboxStats when the runtime does not implement
+// the ListPodSandboxStats RPC (e.g., older containerd and CRI-O releases).
+var ErrSandboxStatsUnimplemented = errors.New("ListPodSandboxStats not implemented by the runtime")
+
+// ListPodSandboxStats retrieves the pod-level statistics of all pod sandboxes from the CRI runtime.
+//
+// The stats include the pause container and the pod overhead, and report the network counters
+// and the process count of the pod, which the container stats do not.
+//
+// Parameters:
+//   - ctx context.Context: standard context for cancellation and timeout propagation.
+//   - runtimeClient cri.RuntimeServiceClient: the CRI client used to issue the stats request.
+//
+// Returns:
+//   - []*cri.PodSandboxStats: a slice of pod sandbox statistics.
+//   - error: ErrSandboxStatsUnimplemented if the runtime does not implement the RPC, another error if the
+//     request fails, or nil on success.
+//   - time.Duration: the total time taken to complete the function, useful for performance monitoring.
+func ListPodSandboxStats(
+	ctx context.Context,
+	runtimeClient cri.RuntimeServiceClient,
+) ([]*cri.PodSandboxStats, error, time.Duration) {
+	start := time.Now()
+
+	resp, err := runtimeClient.ListPodSandboxStats(ctx, &cri.ListPodSandboxStatsRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil, ErrSandboxStatsUnimplemented, time.Since(start)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pod sandbox stats: %v", err.Error()), time.Since(start)
+	}
+
+	return resp.Stats, nil, time.Since(start)
+}
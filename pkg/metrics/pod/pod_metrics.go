@@ -1,6 +1,7 @@
 package pod
 
 import (
+	"github.com/kubensage/kubensage-agent/pkg/labels"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
 )
@@ -9,7 +10,10 @@ import (
 // and a list of container metrics associated with that pod.
 //
 // The resulting PodMetrics contains metadata identifying the pod (ID, name, namespace, UID),
-// lifecycle attributes (creation time, state, attempt), and aggregated container-level metrics.
+// lifecycle attributes (creation time, state, attempt), labels and annotations, the owning
+// workload and application name, and aggregated container-level metrics.
+//
+// The owner and the application name are derived from the labels before they are filtered.
 //
 // Parameters:
 //
@@ -20,6 +24,12 @@ import (
 //   - containersMetrics []*gen.ContainerMetrics:
 //     A slice of ContainerMetrics representing the metrics collected from the pod's containers.
 //
+//   - labelFilter *labels.Filter:
+//     Selects the labels reported and bounds their values.
+//
+//   - annotationFilter *labels.Filter:
+//     Selects the annotations reported and bounds their values.
+//
 // Returns:
 //
 //   - *gen.PodMetrics:
@@ -31,6 +41,8 @@ import (
 func BuildPodMetrics(
 	pod *cri.PodSandbox,
 	containersMetrics []*gen.ContainerMetrics,
+	labelFilter *labels.Filter,
+	annotationFilter *labels.Filter,
 ) (*gen.PodMetrics, error) {
 	return &gen.PodMetrics{
 		Id:               pod.Id,
@@ -41,5 +53,9 @@ func BuildPodMetrics(
 		State:            pod.State.String(),
 		Attempt:          pod.Metadata.Attempt,
 		ContainerMetrics: containersMetrics,
+		Labels:           labelFilter.Apply(pod.Labels),
+		Annotations:      annotationFilter.Apply(pod.Annotations),
+		Owner:            deriveOwner(pod.Metadata.Name, pod.Labels),
+		App:              deriveApp(pod.Labels),
 	}, nil
 }
@@ -0,0 +1,172 @@
+package pod
+
+import (
+	"github.com/kubensage/kubensage-agent/pkg/metrics/container"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+const (
+	statsSourceSandbox       = "sandbox_stats"
+	statsSourceContainersSum = "containers_sum"
+)
+
+// ApplySandboxStats sets the pod-level CPU, memory, network and process stats of a PodMetrics
+// from the stats returned by CRI ListPodSandboxStats.
+//
+// If stats carries no Linux stats (e.g., a Windows sandbox), the pod-level stats are computed
+// from the container metrics instead, as done by ApplyContainersSum.
+//
+// Parameters:
+//   - podMetric *gen.PodMetrics: the pod metrics to update, with its container metrics already set.
+//   - pod *cri.PodSandbox: the pod sandbox the stats belong to.
+//   - stats *cri.PodSandboxStats: the sandbox stats reported by the runtime.
+//   - cpuRates *container.CpuRateTracker: per-sandbox state used to compute the CPU usage in cores.
+func ApplySandboxStats(
+	podMetric *gen.PodMetrics,
+	pod *cri.PodSandbox,
+	stats *cri.PodSandboxStats,
+	cpuRates *container.CpuRateTracker,
+) {
+	linux := stats.GetLinux()
+	if linux == nil {
+		ApplyContainersSum(podMetric)
+		return
+	}
+
+	var attempt uint32
+	if pod.Metadata != nil {
+		attempt = pod.Metadata.Attempt
+	}
+
+	podMetric.StatsSource = statsSourceSandbox
+	podMetric.CpuMetrics = container.ConvertCpuUsage(pod.Id, attempt, pod.CreatedAt, linux.Cpu, cpuRates)
+	podMetric.MemoryMetrics = container.ConvertMemoryUsage(linux.Memory)
+	podMetric.NetworkMetrics = convertNetworkUsage(linux.Network)
+
+	if linux.Process != nil && linux.Process.ProcessCount != nil {
+		podMetric.ProcessCount = utils.ConvertCRIUInt64(linux.Process.ProcessCount)
+	}
+}
+
+// ApplyContainersSum sets the pod-level CPU and memory stats of a PodMetrics to the sum of its
+// container metrics, for runtimes that do not implement ListPodSandboxStats.
+//
+// The sum leaves out the pause container and the pod overhead. A counter is only set when at
+// least one container reports it. The summed UsageCoreNanoSeconds is not monotonic: it goes
+// backwards when a container restarts or leaves the pod, so rates must not be derived from it.
+// UsageCores is only set when every container with CPU stats has a rate, so that a new or
+// restarted container does not make the pod usage dip.
+//
+// Parameters:
+//   - podMetric *gen.PodMetrics: the pod metrics to update, with its container metrics already set.
+func ApplyContainersSum(
+	podMetric *gen.PodMetrics,
+) {
+	cpu := &gen.CpuMetrics{}
+	memory := &gen.MemoryMetrics{}
+	var usageCores float64
+	allCores := true
+
+	for _, c := range podMetric.ContainerMetrics {
+		if c.CpuMetrics != nil {
+			cpu.Timestamp = max(cpu.Timestamp, c.CpuMetrics.Timestamp)
+			cpu.UsageCoreNanoSeconds = addUInt64(cpu.UsageCoreNanoSeconds, c.CpuMetrics.UsageCoreNanoSeconds)
+			cpu.UsageNanoCores = addUInt64(cpu.UsageNanoCores, c.CpuMetrics.UsageNanoCores)
+			if c.CpuMetrics.UsageCores != nil {
+				usageCores += c.CpuMetrics.UsageCores.Value
+			} else if c.CpuMetrics.Timestamp != 0 {
+				// CPU stats reported, but no rate yet (first sample or restarted container).
+				allCores = false
+			}
+		}
+
+		if c.MemoryMetrics != nil {
+			memory.Timestamp = max(memory.Timestamp, c.MemoryMetrics.Timestamp)
+			memory.WorkingSetBytes = addUInt64(memory.WorkingSetBytes, c.MemoryMetrics.WorkingSetBytes)
+			memory.UsageBytes = addUInt64(memory.UsageBytes, c.MemoryMetrics.UsageBytes)
+			memory.RssBytes = addUInt64(memory.RssBytes, c.MemoryMetrics.RssBytes)
+			memory.PageFaults = addUInt64(memory.PageFaults, c.MemoryMetrics.PageFaults)
+			memory.MajorPageFaults = addUInt64(memory.MajorPageFaults, c.MemoryMetrics.MajorPageFaults)
+		}
+	}
+
+	if allCores && cpu.Timestamp != 0 {
+		cpu.UsageCores = wrapperspb.Double(usageCores)
+	}
+
+	podMetric.StatsSource = statsSourceContainersSum
+	podMetric.CpuMetrics = cpu
+	podMetric.MemoryMetrics = memory
+}
+
+// convertNetworkUsage converts the CRI network usage of a pod sandbox into a *gen.PodNetworkMetrics.
+//
+// Parameters:
+//   - network *cri.NetworkUsage: the network usage reported by the runtime (may be nil).
+//
+// Returns:
+//   - *gen.PodNetworkMetrics: the network metrics, or nil if network is nil.
+func convertNetworkUsage(
+	network *cri.NetworkUsage,
+) *gen.PodNetworkMetrics {
+	if network == nil {
+		return nil
+	}
+
+	metrics := &gen.PodNetworkMetrics{
+		Timestamp:        network.Timestamp,
+		DefaultInterface: convertInterfaceUsage(network.DefaultInterface),
+	}
+
+	for _, iface := range network.Interfaces {
+		if iface != nil {
+			metrics.Interfaces = append(metrics.Interfaces, convertInterfaceUsage(iface))
+		}
+	}
+
+	return metrics
+}
+
+// convertInterfaceUsage converts the CRI counters of a pod network interface into a *gen.PodInterfaceStats.
+//
+// Parameters:
+//   - iface *cri.NetworkInterfaceUsage: the interface counters reported by the runtime (may be nil).
+//
+// Returns:
+//   - *gen.PodInterfaceStats: the interface counters, or nil if iface is nil.
+func convertInterfaceUsage(
+	iface *cri.NetworkInterfaceUsage,
+) *gen.PodInterfaceStats {
+	if iface == nil {
+		return nil
+	}
+
+	return &gen.PodInterfaceStats{
+		Name:     iface.Name,
+		RxBytes:  utils.ConvertCRIUInt64(iface.RxBytes),
+		RxErrors: utils.ConvertCRIUInt64(iface.RxErrors),
+		TxBytes:  utils.ConvertCRIUInt64(iface.TxBytes),
+		TxErrors: utils.ConvertCRIUInt64(iface.TxErrors),
+	}
+}
+
+// addUInt64 adds v to sum, treating nil as an unset value rather than zero.
+//
+// Parameters:
+//   - sum *wrapperspb.UInt64Value: the running sum (may be nil).
+//   - v *wrapperspb.UInt64Value: the value to add (may be nil).
+//
+// Returns:
+//   - *wrapperspb.UInt64Value: the new sum, nil if both are nil.
+func addUInt64(
+	sum *wrapperspb.UInt64Value,
+	v *wrapperspb.UInt64Value,
+) *wrapperspb.UInt64Value {
+	if v == nil {
+		return sum
+	}
+	return wrapperspb.UInt64(sum.GetValue() + v.Value)
+}
@@ -5,18 +5,18 @@ import (
 	"errors"
 	"time"
 
-	"github.com/kubensage/go-common/datastructure"
+	"github.com/kubensage/kubensage-agent/pkg/buffer"
 	"github.com/kubensage/kubensage-agent/pkg/cli"
 	"github.com/kubensage/kubensage-agent/proto/gen"
 	"go.uber.org/zap"
 )
 
-// SendOnce attempts to send one batch of metrics from the ring buffer over the provided gRPC stream.
+// SendOnce attempts to send one batch of metrics from the snapshot buffer over the provided gRPC stream.
 //
 // If the stream is nil, it opens a new gRPC stream using the provided MetricsServiceClient.
 // Before sending the next metric, it first flushes the entire buffer using sendAllBuffer().
-// If any error occurs while opening the stream or sending data, it logs the error and returns it,
-// ensuring the stream is marked as unusable (by setting it to nil) for retry on the next call.
+// If any error occurs while opening the stream or sending data, it logs the error and returns it
+// together with a nil stream, so that the caller opens a new stream on the next call.
 //
 // Parameters:
 //   - ctx context.Context:
@@ -25,14 +25,17 @@ import (
 //     gRPC client used to create the metrics stream.
 //   - stream gen.MetricsService_SendMetricsClient:
 //     Current active stream used for sending metrics. If nil, a new one will be created.
-//   - buffer *datastructure.RingBuffer[*gen.Metrics]:
-//     Ring buffer containing collected metrics that need to be sent.
+//   - buffer *buffer.SnapshotBuffer:
+//     Snapshot buffer containing collected metrics that need to be sent.
 //   - agentCfg *cli.AgentConfig:
 //     Agent configuration used for accessing loop timing (used in retries).
 //   - logger *zap.Logger:
 //     Structured logger for debug and error output.
 //
 // Returns:
+//   - gen.MetricsService_SendMetricsClient:
+//     The stream to use on the next call: the (possibly newly opened) stream on success,
+//     or nil if the stream is no longer usable.
 //   - error:
 //     An error is returned if opening the stream or sending metrics fails. The caller
 //     is responsible for handling the failed stream (usually by retrying on the next loop).
@@ -40,10 +43,10 @@ func SendOnce(
 	ctx context.Context,
 	relayClient gen.MetricsServiceClient,
 	stream gen.MetricsService_SendMetricsClient,
-	buffer *datastructure.RingBuffer[*gen.Metrics],
+	buffer *buffer.SnapshotBuffer,
 	agentCfg *cli.AgentConfig,
 	logger *zap.Logger,
-) error {
+) (gen.MetricsService_SendMetricsClient, error) {
 	start := time.Now()
 
 	var err error
@@ -53,7 +56,7 @@ func SendOnce(
 		if err != nil {
 			logger.Warn("unable to open stream", zap.Error(err))
 			time.Sleep(agentCfg.MainLoopDurationSeconds)
-			return err
+			return nil, err
 		}
 		logger.Info("stream opened successfully")
 	}
@@ -68,8 +71,7 @@ func SendOnce(
 			zap.Error(err),
 		)
 
-		stream = nil
-		return err
+		return nil, err
 	}
 
 	sendStart := time.Now()
@@ -78,8 +80,7 @@ func SendOnce(
 			zap.Duration("send_duration", time.Since(sendStart)),
 			zap.Error(err),
 		)
-		stream = nil
-		return err
+		return nil, err
 	}
 
 	logger.Info("metrics send cycle completed",
@@ -88,7 +89,7 @@ func SendOnce(
 		zap.Duration("cycle_total_duration", time.Since(start)),
 	)
 
-	return nil
+	return stream, nil
 }
 
 // sendAllBuffer attempts to flush all pending metrics from the buffer through the gRPC stream.
@@ -98,8 +99,8 @@ func SendOnce(
 // This ensures reliability by avoiding partial or failed batches.
 //
 // Parameters:
-//   - buffer *datastructure.RingBuffer[*gen.Metrics]:
-//     A ring buffer containing queued metrics to be sent.
+//   - buffer *buffer.SnapshotBuffer:
+//     A snapshot buffer containing queued metrics to be sent.
 //   - stream gen.MetricsService_SendMetricsClient:
 //     gRPC client stream used for sending metrics to the relay service.
 //   - logger *zap.Logger:
@@ -109,7 +110,7 @@ func SendOnce(
 //   - error:
 //     Returns the first error encountered while sending, or nil if all metrics were flushed successfully.
 func sendAllBuffer(
-	buffer *datastructure.RingBuffer[*gen.Metrics],
+	buffer *buffer.SnapshotBuffer,
 	stream gen.MetricsService_SendMetricsClient,
 	logger *zap.Logger,
 ) (int, error) {
@@ -140,7 +141,7 @@ func sendAllBuffer(
 	return count, nil
 }
 
-// popAndSend attempts to send a single Metrics message from the ring buffer over a gRPC stream.
+// popAndSend attempts to send a single Metrics message from the snapshot buffer over a gRPC stream.
 //
 // It pops the oldest metric from the buffer and calls stream.Send() to transmit it.
 // If the buffer is empty, the function exits silently. If sending fails, the metric is re-added
@@ -149,8 +150,8 @@ func sendAllBuffer(
 // This function ensures metrics are not lost in case of transient gRPC failures.
 //
 // Parameters:
-//   - buffer *datastructure.RingBuffer[*gen.Metrics]:
-//     A ring buffer holding pending metrics to be sent.
+//   - buffer *buffer.SnapshotBuffer:
+//     A snapshot buffer holding pending metrics to be sent.
 //   - stream gen.MetricsService_SendMetricsClient:
 //     gRPC client stream used to send metrics to the relay service.
 //   - logger *zap.Logger:
@@ -161,7 +162,7 @@ func sendAllBuffer(
 //     Returns an error if the buffer is nil or sending over the stream fails.
 //     Returns nil if the buffer is empty or the metric is sent successfully.
 func popAndSend(
-	buffer *datastructure.RingBuffer[*gen.Metrics],
+	buffer *buffer.SnapshotBuffer,
 	stream gen.MetricsService_SendMetricsClient,
 	logger *zap.Logger,
 ) error {
@@ -173,7 +174,7 @@ func popAndSend(
 		return nil
 	}
 
-	_, pop, ok := buffer.Pop()
+	pop, ok := buffer.Pop()
 	if !ok {
 		logger.Debug("buffer empty after check (race condition?)")
 		return nil
@@ -181,7 +182,7 @@ func popAndSend(
 
 	if err := stream.Send(pop); err != nil {
 		logger.Error("stream send failed", zap.Error(err))
-		buffer.Readd(pop)
+		buffer.Unpop(pop)
 		logger.Debug("metric re-queued", zap.Int("buffer_len", buffer.Len()))
 		return err
 	}
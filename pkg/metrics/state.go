@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/cli"
+	"github.com/kubensage/kubensage-agent/pkg/events"
+	"github.com/kubensage/kubensage-agent/pkg/health"
+	"github.com/kubensage/kubensage-agent/pkg/lifecycle"
+	"github.com/kubensage/kubensage-agent/pkg/metrics/agent"
+	"github.com/kubensage/kubensage-agent/pkg/metrics/container"
+	"github.com/kubensage/kubensage-agent/pkg/metrics/node"
+	"go.uber.org/zap"
+)
+
+// containerResourcesTTL is how long the resources of a container are reused before ContainerStatus
+// is called again; resources only change on an in-place pod resize.
+const containerResourcesTTL = time.Minute
+
+// CollectorState holds the long-lived state shared by consecutive collection cycles,
+// such as the agent health counters and the samplers that compute values between ticks.
+//
+// A single CollectorState is created at startup and passed to every CollectOnce call.
+type CollectorState struct {
+	Health             *health.State             // Agent health and counters, also served by the health endpoints
+	Self               *agent.SelfSampler        // Resource usage sampler of the agent process
+	Node               *node.Samplers            // Node collectors computing values between ticks
+	Events             *events.Watcher           // Kernel events accumulated between ticks
+	ContainerCpu       *container.CpuRateTracker // Container CPU usage between ticks, to compute rates
+	ContainerResources *container.ResourcesCache // Container resources, refreshed periodically
+	PodCpu             *container.CpuRateTracker // Pod sandbox CPU usage between ticks, to compute rates
+	ContainerExits     *container.ExitCache      // Exit details of exited containers, fetched once
+	Lifecycle          *lifecycle.Watcher        // Container lifecycle events accumulated between ticks
+}
+
+// NewCollectorState creates the collector state used by CollectOnce.
+//
+// Parameters:
+//   - ctx context.Context: context for cancellation of the samplers priming reads.
+//   - healthState *health.State: the agent health state shared with the main loop.
+//   - agentCfg *cli.AgentConfig: agent configuration used to set up the samplers.
+//   - logger *zap.Logger: logger for the background watchers and the detected host capabilities.
+//
+// Returns:
+//   - *CollectorState: a state ready for the first collection cycle.
+func NewCollectorState(
+	ctx context.Context,
+	healthState *health.State,
+	agentCfg *cli.AgentConfig,
+	logger *zap.Logger,
+) *CollectorState {
+	samplers := node.NewSamplers(ctx, agentCfg)
+	if !samplers.Psi.Supported() {
+		logger.Warn("PSI not supported by the kernel, pressure metrics are not reported")
+	} else if !samplers.Psi.CgroupSupported() {
+		logger.Warn("per-cgroup PSI unavailable (cgroup v1, or kubepods cgroup not created yet), pod and container pressure are not reported until it is")
+	}
+
+	return &CollectorState{
+		Health:             healthState,
+		Self:               agent.NewSelfSampler(),
+		Node:               samplers,
+		Events:             events.NewWatcher(logger.Named("events")),
+		ContainerCpu:       container.NewCpuRateTracker(),
+		ContainerResources: container.NewResourcesCache(containerResourcesTTL),
+		PodCpu:             container.NewCpuRateTracker(),
+		ContainerExits:     container.NewExitCache(),
+		Lifecycle:          lifecycle.NewWatcher(agentCfg.ContainerEventsPoll, logger.Named("lifecycle")),
+	}
+}
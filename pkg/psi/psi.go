@@ -0,0 +1,128 @@
+// Package psi reads Pressure Stall Information (PSI) of the node (/proc/pressure) and of cgroup v2 groups.
+package psi
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Read parses a PSI file, either node-wide (/proc/pressure/{cpu|memory|io|irq}) or of a
+// cgroup v2 group ({cpu|memory|io}.pressure).
+//
+// The file holds a "some" and/or a "full" line, each with avg10, avg60, avg300 and total
+// values; absent lines (e.g., "some" in /proc/pressure/irq) are left unset.
+//
+// Parameters:
+//   - path string: absolute path of the PSI file.
+//
+// Returns:
+//   - *gen.PsiMetrics: the parsed stall data.
+//   - error: non-nil if the file cannot be read (e.g., PSI disabled or not supported by the kernel).
+func Read(
+	path string,
+) (*gen.PsiMetrics, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	var metrics gen.PsiMetrics
+
+	for scanner.Scan() {
+		parts := strings.Fields(scanner.Text())
+
+		if len(parts) < 5 {
+			continue
+		}
+
+		entry := &gen.PsiData{}
+
+		for _, part := range parts[1:] {
+			key, value, ok := strings.Cut(part, "=")
+			if !ok {
+				continue
+			}
+
+			switch key {
+			case "avg10":
+				if val, err := strconv.ParseFloat(value, 64); err == nil {
+					entry.Avg10 = wrapperspb.Double(val)
+				}
+			case "avg60":
+				if val, err := strconv.ParseFloat(value, 64); err == nil {
+					entry.Avg60 = wrapperspb.Double(val)
+				}
+			case "avg300":
+				if val, err := strconv.ParseFloat(value, 64); err == nil {
+					entry.Avg300 = wrapperspb.Double(val)
+				}
+			case "total":
+				if val, err := strconv.ParseUint(value, 10, 64); err == nil {
+					entry.Total = wrapperspb.UInt64(val)
+				}
+			}
+		}
+
+		switch parts[0] {
+		case "some":
+			metrics.Some = entry
+		case "full":
+			metrics.Full = entry
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+// ReadCgroup reads the cpu, memory and io pressure of a cgroup v2 group.
+//
+// Parameters:
+//   - dir string: absolute path of the cgroup directory (e.g., /sys/fs/cgroup/kubepods.slice).
+//
+// Returns:
+//   - *gen.CgroupPressure: the pressure of the cgroup; resources whose file cannot be read are left unset.
+//   - error: non-nil if none of the files can be read (e.g., cgroup v1, removed cgroup, or PSI disabled).
+func ReadCgroup(
+	dir string,
+) (*gen.CgroupPressure, error) {
+	var pressure gen.CgroupPressure
+	var firstErr error
+	read := 0
+
+	for _, resource := range []struct {
+		file   string
+		target **gen.PsiMetrics
+	}{
+		{"cpu.pressure", &pressure.Cpu},
+		{"memory.pressure", &pressure.Memory},
+		{"io.pressure", &pressure.Io},
+	} {
+		metrics, err := Read(filepath.Join(dir, resource.file))
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		*resource.target = metrics
+		read++
+	}
+
+	if read == 0 {
+		return nil, firstErr
+	}
+
+	return &pressure, nil
+}
@@ -0,0 +1,228 @@
+package psi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubensage/kubensage-agent/pkg/events"
+	"github.com/kubensage/kubensage-agent/pkg/utils"
+	"github.com/kubensage/kubensage-agent/proto/gen"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// minTriggerWindow and maxTriggerWindow are the tracking window bounds accepted by the kernel.
+	minTriggerWindow = 500 * time.Millisecond
+	maxTriggerWindow = 10 * time.Second
+
+	// pollTimeout bounds how long the monitor waits before checking for cancellation.
+	pollTimeout = time.Second
+)
+
+// Trigger is a PSI trigger: it fires when the tasks of the node are stalled on a resource for
+// more than Stall within a Window (e.g., "some 150000 1000000": some tasks stalled on memory
+// for more than 150ms within 1s). The kernel fires a trigger at most once per window.
+type Trigger struct {
+	Resource string        // "cpu", "memory" or "io"
+	Kind     string        // "some" or "full"
+	Stall    time.Duration // Stall time threshold within the window
+	Window   time.Duration // Tracking window, between 500ms and 10s
+}
+
+// ParseTrigger parses a trigger in the kernel format "<some|full> <stall us> <window us>".
+//
+// Parameters:
+//   - resource string: the pressure file the trigger applies to ("cpu", "memory" or "io").
+//   - value string: the trigger (e.g., "some 150000 1000000").
+//
+// Returns:
+//   - Trigger: the parsed trigger.
+//   - error: non-nil if the value is malformed or outside the bounds accepted by the kernel.
+func ParseTrigger(
+	resource string,
+	value string,
+) (Trigger, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q (expected \"<some|full> <stall us> <window us>\")", resource, value)
+	}
+	if fields[0] != "some" && fields[0] != "full" {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q: kind must be \"some\" or \"full\"", resource, value)
+	}
+
+	stall, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q: stall: %v", resource, value, err)
+	}
+	window, err := strconv.ParseUint(fields[2], 10, 32)
+	if err != nil {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q: window: %v", resource, value, err)
+	}
+
+	trigger := Trigger{
+		Resource: resource,
+		Kind:     fields[0],
+		Stall:    time.Duration(stall) * time.Microsecond,
+		Window:   time.Duration(window) * time.Microsecond,
+	}
+	if trigger.Window < minTriggerWindow || trigger.Window > maxTriggerWindow {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q: window must be between %v and %v",
+			resource, value, minTriggerWindow, maxTriggerWindow)
+	}
+	if trigger.Stall == 0 || trigger.Stall > trigger.Window {
+		return Trigger{}, fmt.Errorf("invalid %s trigger %q: stall must be positive and not exceed the window",
+			resource, value)
+	}
+
+	return trigger, nil
+}
+
+// String returns the trigger in the kernel format, as written to the pressure file.
+func (t Trigger) String() string {
+	return fmt.Sprintf("%s %d %d", t.Kind, t.Stall.Microseconds(), t.Window.Microseconds())
+}
+
+// Monitor registers PSI triggers on the node pressure files and waits for them to fire.
+type Monitor struct {
+	triggers []Trigger
+	logger   *zap.Logger
+}
+
+// NewMonitor creates a Monitor for the given triggers.
+//
+// Parameters:
+//   - triggers []Trigger: the triggers to register, at most one per resource is expected.
+//   - logger *zap.Logger: logger for registration failures.
+//
+// Returns:
+//   - *Monitor: the monitor.
+func NewMonitor(
+	triggers []Trigger,
+	logger *zap.Logger,
+) *Monitor {
+	return &Monitor{triggers: triggers, logger: logger}
+}
+
+// Run registers the triggers and calls onFire with a NodeEvent each time one of them fires,
+// until ctx is cancelled.
+//
+// It blocks and is meant to be run in its own goroutine. Triggers that cannot be registered
+// (PSI not supported, or kernel restrictions such as "full" for cpu before Linux 5.13) are
+// logged and skipped; Run returns when no trigger is left. Since Linux 6.5, without
+// CAP_SYS_RESOURCE the kernel only accepts windows that are a multiple of 2s.
+//
+// Parameters:
+//   - ctx context.Context: context whose cancellation stops the monitor.
+//   - onFire func(*gen.NodeEvent): called from the monitor goroutine for each fired trigger.
+func (m *Monitor) Run(
+	ctx context.Context,
+	onFire func(*gen.NodeEvent),
+) {
+	var files []*os.File
+	var triggers []Trigger
+	defer func() {
+		for _, file := range files {
+			_ = file.Close()
+		}
+	}()
+
+	for _, trigger := range m.triggers {
+		file, err := register(trigger)
+		if err != nil {
+			fields := []zap.Field{zap.String("resource", trigger.Resource), zap.String("trigger", trigger.String()), zap.Error(err)}
+			if errors.Is(err, unix.EINVAL) && trigger.Window%(2*time.Second) != 0 {
+				fields = append(fields, zap.String("hint", "without CAP_SYS_RESOURCE the window must be a multiple of 2s"))
+			}
+			m.logger.Warn("failed to register PSI trigger", fields...)
+			continue
+		}
+		m.logger.Info("PSI trigger registered",
+			zap.String("resource", trigger.Resource), zap.String("trigger", trigger.String()))
+		files = append(files, file)
+		triggers = append(triggers, trigger)
+	}
+
+	fds := make([]unix.PollFd, len(files))
+	for i, file := range files {
+		fds[i] = unix.PollFd{Fd: int32(file.Fd()), Events: unix.POLLPRI}
+	}
+
+	active := len(fds)
+	for active > 0 && ctx.Err() == nil {
+		n, err := unix.Poll(fds, int(pollTimeout.Milliseconds()))
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+			m.logger.Warn("PSI trigger monitor stopped", zap.Error(err))
+			return
+		}
+		if n == 0 {
+			continue
+		}
+
+		for i := range fds {
+			switch {
+			case fds[i].Revents&unix.POLLERR != 0:
+				m.logger.Warn("PSI trigger no longer valid", zap.String("resource", triggers[i].Resource))
+				fds[i].Fd = -1 // Ignored by poll from now on
+				active--
+			case fds[i].Revents&unix.POLLPRI != 0:
+				onFire(newTriggerEvent(triggers[i]))
+			}
+		}
+	}
+}
+
+// register opens the pressure file of a trigger and writes the trigger to it. The trigger stays
+// registered as long as the returned file is open.
+func register(
+	trigger Trigger,
+) (*os.File, error) {
+	file, err := os.OpenFile(utils.HostProc("pressure", trigger.Resource), os.O_RDWR|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.WriteString(trigger.String() + "\x00"); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	return file, nil
+}
+
+// newTriggerEvent builds the event reported when a trigger fires, with the current pressure of the resource.
+func newTriggerEvent(
+	trigger Trigger,
+) *gen.NodeEvent {
+	event := &gen.NodeEvent{
+		Type:      events.TypePsiTrigger,
+		Timestamp: time.Now().UnixNano(),
+		Message: fmt.Sprintf("%s pressure: %s tasks stalled for more than %v within %v",
+			trigger.Resource, trigger.Kind, trigger.Stall, trigger.Window),
+		Count: 1,
+		Attributes: map[string]string{
+			"resource":  trigger.Resource,
+			"kind":      trigger.Kind,
+			"stall_us":  strconv.FormatInt(trigger.Stall.Microseconds(), 10),
+			"window_us": strconv.FormatInt(trigger.Window.Microseconds(), 10),
+		},
+	}
+
+	if metrics, err := Read(utils.HostProc("pressure", trigger.Resource)); err == nil {
+		data := metrics.Some
+		if trigger.Kind == "full" {
+			data = metrics.Full
+		}
+		if data != nil && data.Avg10 != nil {
+			event.Attributes["avg10"] = strconv.FormatFloat(data.Avg10.Value, 'f', 2, 64)
+		}
+	}
+
+	return event
+}
@@ -0,0 +1,39 @@
+package utils
+
+import "fmt"
+
+// CollectorError labels an error with the name of the collector that produced it
+// (e.g., "list_pods", "cpu_percent"), so that failures can be grouped per collector
+// when they are reported (logs, status endpoint).
+type CollectorError struct {
+	Collector string // Name of the collector that failed
+	Err       error  // Underlying error
+}
+
+// Error implements the error interface.
+func (e *CollectorError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Collector, e.Err)
+}
+
+// Unwrap returns the underlying error, enabling errors.Is and errors.As.
+func (e *CollectorError) Unwrap() error {
+	return e.Err
+}
+
+// NewCollectorError wraps err into a *CollectorError labelled with the given collector name.
+//
+// Parameters:
+//   - collector string: name of the collector that produced the error.
+//   - err error: the error to wrap. May be nil.
+//
+// Returns:
+//   - error: the labelled error, or nil if err is nil.
+func NewCollectorError(
+	collector string,
+	err error,
+) error {
+	if err == nil {
+		return nil
+	}
+	return &CollectorError{Collector: collector, Err: err}
+}
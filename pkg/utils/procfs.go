@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ApplyHostRoot derives the host /proc and /sys locations from the --host-root prefix, so that
+// a containerized agent reads /proc, /sys and the host filesystems under the same root.
+//
+// The HOST_PROC and HOST_SYS environment variables, honored by HostProc, HostSys and gopsutil,
+// are set to <hostRoot>/proc and <hostRoot>/sys unless they are already set explicitly.
+//
+// Parameters:
+//   - hostRoot string: the prefix of the host filesystem (e.g., "/host"), empty when not in a container.
+func ApplyHostRoot(
+	hostRoot string,
+) {
+	if hostRoot == "" {
+		return
+	}
+	for env, dir := range map[string]string{"HOST_PROC": "proc", "HOST_SYS": "sys"} {
+		if os.Getenv(env) == "" {
+			_ = os.Setenv(env, filepath.Join(hostRoot, dir))
+		}
+	}
+}
+
+// HostProc builds a path under the host /proc filesystem.
+//
+// Like gopsutil, it honors the HOST_PROC environment variable so that a containerized
+// agent can read the host /proc mounted elsewhere (e.g., HOST_PROC=/host/proc). It is
+// derived from --host-root by ApplyHostRoot when not set explicitly.
+//
+// Parameters:
+//   - elem ...string: path elements relative to /proc (e.g., "net", "snmp").
+//
+// Returns:
+//   - string: the joined path (e.g., "/proc/net/snmp").
+func HostProc(
+	elem ...string,
+) string {
+	return hostPath("HOST_PROC", "/proc", elem...)
+}
+
+// HostSys builds a path under the host /sys filesystem, honoring the HOST_SYS environment variable.
+//
+// Parameters:
+//   - elem ...string: path elements relative to /sys (e.g., "class", "net").
+//
+// Returns:
+//   - string: the joined path (e.g., "/sys/class/net").
+func HostSys(
+	elem ...string,
+) string {
+	return hostPath("HOST_SYS", "/sys", elem...)
+}
+
+// HostProcNet builds the path of a file of /proc/net for the host network namespace.
+//
+// /proc/net reflects the network namespace of the reading process, so the file is looked up
+// under /proc/1/net first: with hostPID, PID 1 is the host init process and lives in the host
+// network namespace even if the agent does not. If that path is not accessible, the path under
+// /proc/net of the agent itself is returned.
+//
+// Parameters:
+//   - name string: the file name (e.g., "snmp", "sockstat").
+//
+// Returns:
+//   - string: the path of the file (e.g., "/proc/1/net/snmp").
+func HostProcNet(
+	name string,
+) string {
+	path := HostProc("1", "net", name)
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	return HostProc("net", name)
+}
+
+// hostPath joins elem under the directory found in the given environment variable, or under fallback.
+func hostPath(
+	env string,
+	fallback string,
+	elem ...string,
+) string {
+	root := os.Getenv(env)
+	if root == "" {
+		root = fallback
+	}
+	return filepath.Join(append([]string{root}, elem...)...)
+}
+
+// ReadUint64File reads a file containing a single unsigned integer (e.g., a /proc/sys or cgroup file).
+//
+// Parameters:
+//   - path string: absolute path of the file.
+//
+// Returns:
+//   - uint64: the parsed value.
+//   - error: non-nil if the file cannot be read or does not contain an unsigned integer.
+func ReadUint64File(
+	path string,
+) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// CounterRate computes the per-second rate of a monotonic counter between two samples.
+//
+// A counter that went backwards (e.g., after a reset or wrap-around) yields a zero rate
+// rather than a huge bogus value.
+//
+// Parameters:
+//   - cur uint64: the current counter value.
+//   - prev uint64: the previous counter value.
+//   - elapsed time.Duration: time elapsed between the two samples.
+//
+// Returns:
+//   - float64: the rate per second, or 0 if elapsed is not positive or the counter went backwards.
+func CounterRate(
+	cur uint64,
+	prev uint64,
+	elapsed time.Duration,
+) float64 {
+	if elapsed <= 0 || cur < prev {
+		return 0
+	}
+	return float64(cur-prev) / elapsed.Seconds()
+}
+
+// CounterDelta computes the increase of a monotonic counter between two samples.
+//
+// Parameters:
+//   - cur uint64: the current counter value.
+//   - prev uint64: the previous counter value.
+//
+// Returns:
+//   - uint64: cur - prev, or 0 if the counter went backwards (e.g., after a reset or wrap-around).
+func CounterDelta(
+	cur uint64,
+	prev uint64,
+) uint64 {
+	if cur < prev {
+		return 0
+	}
+	return cur - prev
+}
@@ -0,0 +1,303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: proto/agent_stats.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AgentStats is the self-telemetry of the agent at the time a snapshot was collected.
+// It allows the relay to detect unhealthy agents (slow collections, full buffers,
+// failing sends, runaway resource usage) across the fleet.
+type AgentStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Agent version (as defined in pkg/buildinfo.Version).
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Go runtime version the agent was built with (e.g., "go1.24.4").
+	GoVersion string `protobuf:"bytes,2,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Total duration of the collection cycle that produced this snapshot, in nanoseconds.
+	CollectionDurationNs int64 `protobuf:"varint,3,opt,name=collection_duration_ns,json=collectionDurationNs,proto3" json:"collection_duration_ns,omitempty"`
+	// Duration of each individual probe of the collection cycle, in nanoseconds,
+	// keyed by probe name (e.g., "list_pods", "node.cpu_percent_total").
+	ProbeDurationsNs map[string]int64 `protobuf:"bytes,4,rep,name=probe_durations_ns,json=probeDurationsNs,proto3" json:"probe_durations_ns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// ID of the container whose metrics took the longest to build.
+	SlowestContainerId string `protobuf:"bytes,5,opt,name=slowest_container_id,json=slowestContainerId,proto3" json:"slowest_container_id,omitempty"`
+	// Time taken to build the metrics of the slowest container, in nanoseconds.
+	SlowestContainerDurationNs int64 `protobuf:"varint,6,opt,name=slowest_container_duration_ns,json=slowestContainerDurationNs,proto3" json:"slowest_container_duration_ns,omitempty"`
+	// Number of errors encountered during the collection cycle.
+	CollectionErrors uint32 `protobuf:"varint,7,opt,name=collection_errors,json=collectionErrors,proto3" json:"collection_errors,omitempty"`
+	// Number of snapshots waiting in the buffer when this snapshot was collected.
+	BufferLen uint32 `protobuf:"varint,8,opt,name=buffer_len,json=bufferLen,proto3" json:"buffer_len,omitempty"`
+	// Byte budget of the buffer.
+	BufferMaxBytes uint64 `protobuf:"varint,9,opt,name=buffer_max_bytes,json=bufferMaxBytes,proto3" json:"buffer_max_bytes,omitempty"`
+	// Snapshots discarded since agent start, because the buffer exceeded its byte budget
+	// or because they were older than the buffer retention.
+	DroppedSnapshots uint64 `protobuf:"varint,10,opt,name=dropped_snapshots,json=droppedSnapshots,proto3" json:"dropped_snapshots,omitempty"`
+	// Failed send cycles since agent start.
+	SendFailures uint64 `protobuf:"varint,11,opt,name=send_failures,json=sendFailures,proto3" json:"send_failures,omitempty"`
+	// Number of times the relay stream was re-opened after a failure since agent start.
+	Reconnects uint64 `protobuf:"varint,12,opt,name=reconnects,proto3" json:"reconnects,omitempty"`
+	// Resident set size of the agent process in bytes.
+	RssBytes uint64 `protobuf:"varint,13,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	// CPU usage of the agent process since the previous snapshot (100 = one full core).
+	CpuPercent float64 `protobuf:"fixed64,14,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	// Number of goroutines currently running in the agent.
+	Goroutines uint32 `protobuf:"varint,15,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	// Serialized size in bytes of the snapshots waiting in the buffer.
+	BufferBytes uint64 `protobuf:"varint,16,opt,name=buffer_bytes,json=bufferBytes,proto3" json:"buffer_bytes,omitempty"`
+	// Snapshots whose pod metrics were removed to save buffer space since agent start.
+	StrippedSnapshots uint64 `protobuf:"varint,17,opt,name=stripped_snapshots,json=strippedSnapshots,proto3" json:"stripped_snapshots,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AgentStats) Reset() {
+	*x = AgentStats{}
+	mi := &file_proto_agent_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AgentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentStats) ProtoMessage() {}
+
+func (x *AgentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_agent_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentStats.ProtoReflect.Descriptor instead.
+func (*AgentStats) Descriptor() ([]byte, []int) {
+	return file_proto_agent_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AgentStats) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AgentStats) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *AgentStats) GetCollectionDurationNs() int64 {
+	if x != nil {
+		return x.CollectionDurationNs
+	}
+	return 0
+}
+
+func (x *AgentStats) GetProbeDurationsNs() map[string]int64 {
+	if x != nil {
+		return x.ProbeDurationsNs
+	}
+	return nil
+}
+
+func (x *AgentStats) GetSlowestContainerId() string {
+	if x != nil {
+		return x.SlowestContainerId
+	}
+	return ""
+}
+
+func (x *AgentStats) GetSlowestContainerDurationNs() int64 {
+	if x != nil {
+		return x.SlowestContainerDurationNs
+	}
+	return 0
+}
+
+func (x *AgentStats) GetCollectionErrors() uint32 {
+	if x != nil {
+		return x.CollectionErrors
+	}
+	return 0
+}
+
+func (x *AgentStats) GetBufferLen() uint32 {
+	if x != nil {
+		return x.BufferLen
+	}
+	return 0
+}
+
+func (x *AgentStats) GetBufferMaxBytes() uint64 {
+	if x != nil {
+		return x.BufferMaxBytes
+	}
+	return 0
+}
+
+func (x *AgentStats) GetDroppedSnapshots() uint64 {
+	if x != nil {
+		return x.DroppedSnapshots
+	}
+	return 0
+}
+
+func (x *AgentStats) GetSendFailures() uint64 {
+	if x != nil {
+		return x.SendFailures
+	}
+	return 0
+}
+
+func (x *AgentStats) GetReconnects() uint64 {
+	if x != nil {
+		return x.Reconnects
+	}
+	return 0
+}
+
+func (x *AgentStats) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *AgentStats) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *AgentStats) GetGoroutines() uint32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *AgentStats) GetBufferBytes() uint64 {
+	if x != nil {
+		return x.BufferBytes
+	}
+	return 0
+}
+
+func (x *AgentStats) GetStrippedSnapshots() uint64 {
+	if x != nil {
+		return x.StrippedSnapshots
+	}
+	return 0
+}
+
+var File_proto_agent_stats_proto protoreflect.FileDescriptor
+
+const file_proto_agent_stats_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/agent_stats.proto\x12\ametrics\"\xa6\x06\n" +
+	"\n" +
+	"AgentStats\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1d\n" +
+	"\n" +
+	"go_version\x18\x02 \x01(\tR\tgoVersion\x124\n" +
+	"\x16collection_duration_ns\x18\x03 \x01(\x03R\x14collectionDurationNs\x12W\n" +
+	"\x12probe_durations_ns\x18\x04 \x03(\v2).metrics.AgentStats.ProbeDurationsNsEntryR\x10probeDurationsNs\x120\n" +
+	"\x14slowest_container_id\x18\x05 \x01(\tR\x12slowestContainerId\x12A\n" +
+	"\x1dslowest_container_duration_ns\x18\x06 \x01(\x03R\x1aslowestContainerDurationNs\x12+\n" +
+	"\x11collection_errors\x18\a \x01(\rR\x10collectionErrors\x12\x1d\n" +
+	"\n" +
+	"buffer_len\x18\b \x01(\rR\tbufferLen\x12(\n" +
+	"\x10buffer_max_bytes\x18\t \x01(\x04R\x0ebufferMaxBytes\x12+\n" +
+	"\x11dropped_snapshots\x18\n" +
+	" \x01(\x04R\x10droppedSnapshots\x12#\n" +
+	"\rsend_failures\x18\v \x01(\x04R\fsendFailures\x12\x1e\n" +
+	"\n" +
+	"reconnects\x18\f \x01(\x04R\n" +
+	"reconnects\x12\x1b\n" +
+	"\trss_bytes\x18\r \x01(\x04R\brssBytes\x12\x1f\n" +
+	"\vcpu_percent\x18\x0e \x01(\x01R\n" +
+	"cpuPercent\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x0f \x01(\rR\n" +
+	"goroutines\x12!\n" +
+	"\fbuffer_bytes\x18\x10 \x01(\x04R\vbufferBytes\x12-\n" +
+	"\x12stripped_snapshots\x18\x11 \x01(\x04R\x11strippedSnapshots\x1aC\n" +
+	"\x15ProbeDurationsNsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01B\fZ\n" +
+	"/proto/genb\x06proto3"
+
+var (
+	file_proto_agent_stats_proto_rawDescOnce sync.Once
+	file_proto_agent_stats_proto_rawDescData []byte
+)
+
+func file_proto_agent_stats_proto_rawDescGZIP() []byte {
+	file_proto_agent_stats_proto_rawDescOnce.Do(func() {
+		file_proto_agent_stats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_agent_stats_proto_rawDesc), len(file_proto_agent_stats_proto_rawDesc)))
+	})
+	return file_proto_agent_stats_proto_rawDescData
+}
+
+var file_proto_agent_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_agent_stats_proto_goTypes = []any{
+	(*AgentStats)(nil), // 0: metrics.AgentStats
+	nil,                // 1: metrics.AgentStats.ProbeDurationsNsEntry
+}
+var file_proto_agent_stats_proto_depIdxs = []int32{
+	1, // 0: metrics.AgentStats.probe_durations_ns:type_name -> metrics.AgentStats.ProbeDurationsNsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_agent_stats_proto_init() }
+func file_proto_agent_stats_proto_init() {
+	if File_proto_agent_stats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_agent_stats_proto_rawDesc), len(file_proto_agent_stats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_agent_stats_proto_goTypes,
+		DependencyIndexes: file_proto_agent_stats_proto_depIdxs,
+		MessageInfos:      file_proto_agent_stats_proto_msgTypes,
+	}.Build()
+	File_proto_agent_stats_proto = out.File
+	file_proto_agent_stats_proto_goTypes = nil
+	file_proto_agent_stats_proto_depIdxs = nil
+}
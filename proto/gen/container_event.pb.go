@@ -0,0 +1,242 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: proto/container_event.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ContainerEvent is a lifecycle transition of a container, received from the CRI GetContainerEvents stream
+// or detected by polling the container list on runtimes that do not implement it.
+// Events are reported once, in the first snapshot collected after they occurred.
+type ContainerEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of transition: "created", "started", "stopped" or "deleted".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Time of the transition in nanoseconds since epoch. Exact with the CRI stream; with polling, the creation,
+	// start and finish times reported by the runtime, or the detection time when unknown (e.g., deletions).
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// How the event was obtained: "cri_events" or "polling".
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	// ID of the container (matches ContainerMetrics.id).
+	ContainerId string `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Name of the container in the pod spec, empty if unknown (e.g., deletion seen by the stream).
+	ContainerName string `protobuf:"bytes,5,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	// Attempt of the container, i.e. the number of times kubelet restarted it.
+	Attempt uint32 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// ID of the pod sandbox of the container (matches PodMetrics.id).
+	PodSandboxId string `protobuf:"bytes,7,opt,name=pod_sandbox_id,json=podSandboxId,proto3" json:"pod_sandbox_id,omitempty"`
+	// UID of the pod (matches PodMetrics.uid).
+	PodUid string `protobuf:"bytes,8,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	// Name of the pod.
+	PodName string `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	// Namespace of the pod.
+	PodNamespace string `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"pod_namespace,omitempty"`
+	// Exit code of the container, set on "stopped" events only.
+	ExitCode *wrapperspb.Int32Value `protobuf:"bytes,11,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Reason of the termination (e.g., "Completed", "Error", "OOMKilled"), set on "stopped" events only.
+	Reason        string `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerEvent) Reset() {
+	*x = ContainerEvent{}
+	mi := &file_proto_container_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerEvent) ProtoMessage() {}
+
+func (x *ContainerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerEvent.ProtoReflect.Descriptor instead.
+func (*ContainerEvent) Descriptor() ([]byte, []int) {
+	return file_proto_container_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContainerEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ContainerEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ContainerEvent) GetPodSandboxId() string {
+	if x != nil {
+		return x.PodSandboxId
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetPodUid() string {
+	if x != nil {
+		return x.PodUid
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetPodNamespace() string {
+	if x != nil {
+		return x.PodNamespace
+	}
+	return ""
+}
+
+func (x *ContainerEvent) GetExitCode() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.ExitCode
+	}
+	return nil
+}
+
+func (x *ContainerEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_proto_container_event_proto protoreflect.FileDescriptor
+
+const file_proto_container_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/container_event.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\"\x8f\x03\n" +
+	"\x0eContainerEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12!\n" +
+	"\fcontainer_id\x18\x04 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_name\x18\x05 \x01(\tR\rcontainerName\x12\x18\n" +
+	"\aattempt\x18\x06 \x01(\rR\aattempt\x12$\n" +
+	"\x0epod_sandbox_id\x18\a \x01(\tR\fpodSandboxId\x12\x17\n" +
+	"\apod_uid\x18\b \x01(\tR\x06podUid\x12\x19\n" +
+	"\bpod_name\x18\t \x01(\tR\apodName\x12#\n" +
+	"\rpod_namespace\x18\n" +
+	" \x01(\tR\fpodNamespace\x128\n" +
+	"\texit_code\x18\v \x01(\v2\x1b.google.protobuf.Int32ValueR\bexitCode\x12\x16\n" +
+	"\x06reason\x18\f \x01(\tR\x06reasonB\fZ\n" +
+	"/proto/genb\x06proto3"
+
+var (
+	file_proto_container_event_proto_rawDescOnce sync.Once
+	file_proto_container_event_proto_rawDescData []byte
+)
+
+func file_proto_container_event_proto_rawDescGZIP() []byte {
+	file_proto_container_event_proto_rawDescOnce.Do(func() {
+		file_proto_container_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_container_event_proto_rawDesc), len(file_proto_container_event_proto_rawDesc)))
+	})
+	return file_proto_container_event_proto_rawDescData
+}
+
+var file_proto_container_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_container_event_proto_goTypes = []any{
+	(*ContainerEvent)(nil),        // 0: metrics.ContainerEvent
+	(*wrapperspb.Int32Value)(nil), // 1: google.protobuf.Int32Value
+}
+var file_proto_container_event_proto_depIdxs = []int32{
+	1, // 0: metrics.ContainerEvent.exit_code:type_name -> google.protobuf.Int32Value
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_container_event_proto_init() }
+func file_proto_container_event_proto_init() {
+	if File_proto_container_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_container_event_proto_rawDesc), len(file_proto_container_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_container_event_proto_goTypes,
+		DependencyIndexes: file_proto_container_event_proto_depIdxs,
+		MessageInfos:      file_proto_container_event_proto_msgTypes,
+	}.Build()
+	File_proto_container_event_proto = out.File
+	file_proto_container_event_proto_goTypes = nil
+	file_proto_container_event_proto_depIdxs = nil
+}
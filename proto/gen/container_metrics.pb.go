@@ -44,7 +44,27 @@ type ContainerMetrics struct {
 	// Filesystem usage metrics.
 	FileSystemMetrics *FileSystemMetrics `protobuf:"bytes,9,opt,name=file_system_metrics,json=fileSystemMetrics,proto3" json:"file_system_metrics,omitempty"`
 	// Swap usage metrics.
-	SwapMetrics   *SwapMetrics `protobuf:"bytes,10,opt,name=swap_metrics,json=swapMetrics,proto3" json:"swap_metrics,omitempty"`
+	SwapMetrics *SwapMetrics `protobuf:"bytes,10,opt,name=swap_metrics,json=swapMetrics,proto3" json:"swap_metrics,omitempty"`
+	// Pressure stall information of the container cgroup, unset when per-cgroup PSI is unavailable (cgroup v1).
+	Pressure *CgroupPressure `protobuf:"bytes,11,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Statistics read directly from the cgroup of the container (cgroup v1 or v2), unset when the
+	// cgroup cannot be found (e.g., container not running).
+	CgroupMetrics *CgroupMetrics `protobuf:"bytes,12,opt,name=cgroup_metrics,json=cgroupMetrics,proto3" json:"cgroup_metrics,omitempty"`
+	// Resources (CPU shares, quota and period, memory limit, cpuset) applied to the container, unset when
+	// they cannot be determined (e.g., container not running).
+	Resources *ContainerResources `protobuf:"bytes,13,opt,name=resources,proto3" json:"resources,omitempty"`
+	// CPU usage as a percentage of the CPU limit (quota / period), unset when the container has no CPU limit.
+	CpuLimitPercent *wrapperspb.DoubleValue `protobuf:"bytes,14,opt,name=cpu_limit_percent,json=cpuLimitPercent,proto3" json:"cpu_limit_percent,omitempty"`
+	// Working set as a percentage of the memory limit, unset when the container has no memory limit.
+	MemoryLimitPercent *wrapperspb.DoubleValue `protobuf:"bytes,15,opt,name=memory_limit_percent,json=memoryLimitPercent,proto3" json:"memory_limit_percent,omitempty"`
+	// Labels of the container (mostly the kubelet "io.kubernetes.*" metadata), selected with --label-include and
+	// --label-exclude.
+	Labels map[string]string `protobuf:"bytes,16,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations of the container (e.g., restart count, termination message path), selected with
+	// --annotation-include and --annotation-exclude.
+	Annotations map[string]string `protobuf:"bytes,17,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Exit details of an exited container, from the CRI ContainerStatus; unset for containers that are not exited.
+	Exit          *ContainerExit `protobuf:"bytes,18,opt,name=exit,proto3" json:"exit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -149,6 +169,62 @@ func (x *ContainerMetrics) GetSwapMetrics() *SwapMetrics {
 	return nil
 }
 
+func (x *ContainerMetrics) GetPressure() *CgroupPressure {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetCgroupMetrics() *CgroupMetrics {
+	if x != nil {
+		return x.CgroupMetrics
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetResources() *ContainerResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetCpuLimitPercent() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.CpuLimitPercent
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetMemoryLimitPercent() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MemoryLimitPercent
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ContainerMetrics) GetExit() *ContainerExit {
+	if x != nil {
+		return x.Exit
+	}
+	return nil
+}
+
 // CpuMetrics represents CPU usage statistics for a container at a specific point in time.
 type CpuMetrics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -159,8 +235,11 @@ type CpuMetrics struct {
 	// Cumulative CPU usage across all cores, in core-nanoseconds, since container creation.
 	// This represents the total active CPU time.
 	UsageCoreNanoSeconds *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=usage_core_nano_seconds,json=usageCoreNanoSeconds,proto3" json:"usage_core_nano_seconds,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// CPU usage in cores (e.g., 0.25 for a quarter of a core), computed by the agent from usage_core_nano_seconds
+	// over its own collection interval. Unset on the first sample of a container and after it restarted.
+	UsageCores    *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=usage_cores,json=usageCores,proto3" json:"usage_cores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CpuMetrics) Reset() {
@@ -214,6 +293,13 @@ func (x *CpuMetrics) GetUsageCoreNanoSeconds() *wrapperspb.UInt64Value {
 	return nil
 }
 
+func (x *CpuMetrics) GetUsageCores() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.UsageCores
+	}
+	return nil
+}
+
 // MemoryMetrics provides detailed memory usage statistics for a container.
 type MemoryMetrics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -451,11 +537,719 @@ func (x *SwapMetrics) GetUsageBytes() *wrapperspb.UInt64Value {
 	return nil
 }
 
+// CgroupMetrics reports the statistics of a container cgroup, read from the cgroup filesystem
+// rather than from the CRI, including CPU throttling and the memory breakdown.
+type CgroupMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection timestamp in nanoseconds since epoch.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Version of the cgroup hierarchy the statistics were read from (1 or 2).
+	Version uint32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Path of the cgroup relative to the hierarchy root (e.g., "/kubepods.slice/.../cri-containerd-<id>.scope").
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// CPU usage, CFS bandwidth limit and throttling.
+	Cpu *CgroupCpuStats `protobuf:"bytes,4,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// Memory usage, limit, breakdown and limit events.
+	Memory *CgroupMemoryStats `protobuf:"bytes,5,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Block I/O summed over all devices.
+	Io *CgroupIoStats `protobuf:"bytes,6,opt,name=io,proto3" json:"io,omitempty"`
+	// Number of tasks and limit.
+	Pids *CgroupPidsStats `protobuf:"bytes,7,opt,name=pids,proto3" json:"pids,omitempty"`
+	// Huge pages usage in bytes, keyed by page size (e.g., "2MB", "1GB").
+	HugetlbUsageBytes map[string]uint64 `protobuf:"bytes,8,rep,name=hugetlb_usage_bytes,json=hugetlbUsageBytes,proto3" json:"hugetlb_usage_bytes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CgroupMetrics) Reset() {
+	*x = CgroupMetrics{}
+	mi := &file_proto_container_metrics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupMetrics) ProtoMessage() {}
+
+func (x *CgroupMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupMetrics.ProtoReflect.Descriptor instead.
+func (*CgroupMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CgroupMetrics) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CgroupMetrics) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CgroupMetrics) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CgroupMetrics) GetCpu() *CgroupCpuStats {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *CgroupMetrics) GetMemory() *CgroupMemoryStats {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *CgroupMetrics) GetIo() *CgroupIoStats {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+func (x *CgroupMetrics) GetPids() *CgroupPidsStats {
+	if x != nil {
+		return x.Pids
+	}
+	return nil
+}
+
+func (x *CgroupMetrics) GetHugetlbUsageBytes() map[string]uint64 {
+	if x != nil {
+		return x.HugetlbUsageBytes
+	}
+	return nil
+}
+
+// CgroupCpuStats reports the CPU usage and CFS bandwidth throttling of a cgroup (cpu.stat, cpu.max
+// on cgroup v2; cpuacct.usage, cpuacct.stat, cpu.stat, cpu.cfs_quota_us and cpu.cfs_period_us on cgroup v1).
+type CgroupCpuStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cumulative CPU time in microseconds.
+	UsageUsec uint64 `protobuf:"varint,1,opt,name=usage_usec,json=usageUsec,proto3" json:"usage_usec,omitempty"`
+	// Cumulative CPU time spent in user mode in microseconds.
+	UserUsec uint64 `protobuf:"varint,2,opt,name=user_usec,json=userUsec,proto3" json:"user_usec,omitempty"`
+	// Cumulative CPU time spent in kernel mode in microseconds.
+	SystemUsec uint64 `protobuf:"varint,3,opt,name=system_usec,json=systemUsec,proto3" json:"system_usec,omitempty"`
+	// Number of CFS enforcement periods that have elapsed with runnable tasks.
+	Periods uint64 `protobuf:"varint,4,opt,name=periods,proto3" json:"periods,omitempty"`
+	// Number of periods in which the cgroup was throttled (exhausted its quota).
+	ThrottledPeriods uint64 `protobuf:"varint,5,opt,name=throttled_periods,json=throttledPeriods,proto3" json:"throttled_periods,omitempty"`
+	// Cumulative time in microseconds during which the tasks of the cgroup were throttled.
+	ThrottledUsec uint64 `protobuf:"varint,6,opt,name=throttled_usec,json=throttledUsec,proto3" json:"throttled_usec,omitempty"`
+	// CFS quota per period in microseconds (the CPU limit is quota / period cores), unset when unlimited.
+	QuotaUsec *wrapperspb.UInt64Value `protobuf:"bytes,7,opt,name=quota_usec,json=quotaUsec,proto3" json:"quota_usec,omitempty"`
+	// CFS period in microseconds.
+	PeriodUsec    uint64 `protobuf:"varint,8,opt,name=period_usec,json=periodUsec,proto3" json:"period_usec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupCpuStats) Reset() {
+	*x = CgroupCpuStats{}
+	mi := &file_proto_container_metrics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupCpuStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupCpuStats) ProtoMessage() {}
+
+func (x *CgroupCpuStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupCpuStats.ProtoReflect.Descriptor instead.
+func (*CgroupCpuStats) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CgroupCpuStats) GetUsageUsec() uint64 {
+	if x != nil {
+		return x.UsageUsec
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetUserUsec() uint64 {
+	if x != nil {
+		return x.UserUsec
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetSystemUsec() uint64 {
+	if x != nil {
+		return x.SystemUsec
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetPeriods() uint64 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetThrottledPeriods() uint64 {
+	if x != nil {
+		return x.ThrottledPeriods
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetThrottledUsec() uint64 {
+	if x != nil {
+		return x.ThrottledUsec
+	}
+	return 0
+}
+
+func (x *CgroupCpuStats) GetQuotaUsec() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.QuotaUsec
+	}
+	return nil
+}
+
+func (x *CgroupCpuStats) GetPeriodUsec() uint64 {
+	if x != nil {
+		return x.PeriodUsec
+	}
+	return 0
+}
+
+// CgroupMemoryStats reports the memory usage, breakdown and limit events of a cgroup (memory.current,
+// memory.max, memory.stat and memory.events on cgroup v2; their memory.* equivalents on cgroup v1).
+type CgroupMemoryStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current memory usage in bytes, page cache included.
+	UsageBytes uint64 `protobuf:"varint,1,opt,name=usage_bytes,json=usageBytes,proto3" json:"usage_bytes,omitempty"`
+	// Memory limit in bytes, unset when unlimited.
+	LimitBytes *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=limit_bytes,json=limitBytes,proto3" json:"limit_bytes,omitempty"`
+	// Anonymous memory in bytes (heap, stacks; "rss" on cgroup v1).
+	AnonBytes uint64 `protobuf:"varint,3,opt,name=anon_bytes,json=anonBytes,proto3" json:"anon_bytes,omitempty"`
+	// Page cache in bytes ("cache" on cgroup v1).
+	FileBytes uint64 `protobuf:"varint,4,opt,name=file_bytes,json=fileBytes,proto3" json:"file_bytes,omitempty"`
+	// Kernel memory in bytes (slab, stacks, page tables; memory.kmem.usage_in_bytes on cgroup v1).
+	KernelBytes uint64 `protobuf:"varint,5,opt,name=kernel_bytes,json=kernelBytes,proto3" json:"kernel_bytes,omitempty"`
+	// Shared memory (tmpfs, shm) in bytes.
+	ShmemBytes uint64 `protobuf:"varint,6,opt,name=shmem_bytes,json=shmemBytes,proto3" json:"shmem_bytes,omitempty"`
+	// Slab memory in bytes (cgroup v2 only).
+	SlabBytes uint64 `protobuf:"varint,7,opt,name=slab_bytes,json=slabBytes,proto3" json:"slab_bytes,omitempty"`
+	// Memory used by network socket buffers in bytes (memory.kmem.tcp.usage_in_bytes on cgroup v1).
+	SockBytes uint64 `protobuf:"varint,8,opt,name=sock_bytes,json=sockBytes,proto3" json:"sock_bytes,omitempty"`
+	// Number of times the cgroup went over its high boundary and was throttled (cgroup v2 only).
+	HighEvents uint64 `protobuf:"varint,9,opt,name=high_events,json=highEvents,proto3" json:"high_events,omitempty"`
+	// Number of times the usage was about to go over the limit (memory.failcnt on cgroup v1).
+	MaxEvents uint64 `protobuf:"varint,10,opt,name=max_events,json=maxEvents,proto3" json:"max_events,omitempty"`
+	// Number of times the usage reached the limit and allocations failed (cgroup v2 only).
+	OomEvents uint64 `protobuf:"varint,11,opt,name=oom_events,json=oomEvents,proto3" json:"oom_events,omitempty"`
+	// Number of processes of the cgroup killed by the OOM killer.
+	OomKillEvents uint64 `protobuf:"varint,12,opt,name=oom_kill_events,json=oomKillEvents,proto3" json:"oom_kill_events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupMemoryStats) Reset() {
+	*x = CgroupMemoryStats{}
+	mi := &file_proto_container_metrics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupMemoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupMemoryStats) ProtoMessage() {}
+
+func (x *CgroupMemoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupMemoryStats.ProtoReflect.Descriptor instead.
+func (*CgroupMemoryStats) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CgroupMemoryStats) GetUsageBytes() uint64 {
+	if x != nil {
+		return x.UsageBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetLimitBytes() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.LimitBytes
+	}
+	return nil
+}
+
+func (x *CgroupMemoryStats) GetAnonBytes() uint64 {
+	if x != nil {
+		return x.AnonBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetFileBytes() uint64 {
+	if x != nil {
+		return x.FileBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetKernelBytes() uint64 {
+	if x != nil {
+		return x.KernelBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetShmemBytes() uint64 {
+	if x != nil {
+		return x.ShmemBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetSlabBytes() uint64 {
+	if x != nil {
+		return x.SlabBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetSockBytes() uint64 {
+	if x != nil {
+		return x.SockBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetHighEvents() uint64 {
+	if x != nil {
+		return x.HighEvents
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetMaxEvents() uint64 {
+	if x != nil {
+		return x.MaxEvents
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetOomEvents() uint64 {
+	if x != nil {
+		return x.OomEvents
+	}
+	return 0
+}
+
+func (x *CgroupMemoryStats) GetOomKillEvents() uint64 {
+	if x != nil {
+		return x.OomKillEvents
+	}
+	return 0
+}
+
+// CgroupIoStats reports the block I/O of a cgroup, summed over all devices (io.stat on cgroup v2,
+// blkio.throttle.io_service_bytes and blkio.throttle.io_serviced on cgroup v1).
+type CgroupIoStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bytes read.
+	ReadBytes uint64 `protobuf:"varint,1,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// Bytes written.
+	WriteBytes uint64 `protobuf:"varint,2,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// Read operations.
+	ReadOps uint64 `protobuf:"varint,3,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	// Write operations.
+	WriteOps      uint64 `protobuf:"varint,4,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupIoStats) Reset() {
+	*x = CgroupIoStats{}
+	mi := &file_proto_container_metrics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupIoStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupIoStats) ProtoMessage() {}
+
+func (x *CgroupIoStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupIoStats.ProtoReflect.Descriptor instead.
+func (*CgroupIoStats) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CgroupIoStats) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *CgroupIoStats) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *CgroupIoStats) GetReadOps() uint64 {
+	if x != nil {
+		return x.ReadOps
+	}
+	return 0
+}
+
+func (x *CgroupIoStats) GetWriteOps() uint64 {
+	if x != nil {
+		return x.WriteOps
+	}
+	return 0
+}
+
+// CgroupPidsStats reports the number of tasks (processes and threads) of a cgroup and its limit.
+type CgroupPidsStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current number of tasks.
+	Current uint64 `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	// Maximum number of tasks, unset when unlimited.
+	Max           *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupPidsStats) Reset() {
+	*x = CgroupPidsStats{}
+	mi := &file_proto_container_metrics_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupPidsStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupPidsStats) ProtoMessage() {}
+
+func (x *CgroupPidsStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupPidsStats.ProtoReflect.Descriptor instead.
+func (*CgroupPidsStats) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CgroupPidsStats) GetCurrent() uint64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *CgroupPidsStats) GetMax() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+// ContainerExit reports how and when an exited container terminated.
+type ContainerExit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exit code of the container process.
+	ExitCode int32 `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	// Brief reason of the termination (e.g., "Completed", "Error", "OOMKilled").
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Termination message of the container (from its termination message path), possibly empty.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Start timestamp in nanoseconds since epoch, 0 if the container never started.
+	StartedAt int64 `protobuf:"varint,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Finish timestamp in nanoseconds since epoch.
+	FinishedAt int64 `protobuf:"varint,5,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	// Number of times kubelet restarted the container, from the "io.kubernetes.container.restartCount" annotation
+	// (or the attempt when the annotation is missing).
+	RestartCount  uint32 `protobuf:"varint,6,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerExit) Reset() {
+	*x = ContainerExit{}
+	mi := &file_proto_container_metrics_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerExit) ProtoMessage() {}
+
+func (x *ContainerExit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerExit.ProtoReflect.Descriptor instead.
+func (*ContainerExit) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ContainerExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ContainerExit) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ContainerExit) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContainerExit) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *ContainerExit) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+func (x *ContainerExit) GetRestartCount() uint32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+// ContainerResources reports the resources applied to a container, as set by kubelet from the pod spec:
+// the CPU request maps to the CPU shares, the CPU limit to the CFS quota, the memory limit to the cgroup limit.
+type ContainerResources struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Where the values were read from: "cri" (ContainerStatus) or "cgroup" (cgroup files, when the runtime
+	// does not report resources).
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// CPU shares (relative weight, kubelet sets 1024 per requested core, minimum 2). On cgroup v2 the
+	// cgroup fallback converts cpu.weight back to shares.
+	CpuShares uint64 `protobuf:"varint,2,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`
+	// CFS quota per period in microseconds, unset when unlimited.
+	CpuQuotaUsec *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=cpu_quota_usec,json=cpuQuotaUsec,proto3" json:"cpu_quota_usec,omitempty"`
+	// CFS period in microseconds.
+	CpuPeriodUsec uint64 `protobuf:"varint,4,opt,name=cpu_period_usec,json=cpuPeriodUsec,proto3" json:"cpu_period_usec,omitempty"`
+	// CPU limit in cores (quota / period), unset when unlimited.
+	CpuLimitCores *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=cpu_limit_cores,json=cpuLimitCores,proto3" json:"cpu_limit_cores,omitempty"`
+	// CPU request in cores derived from the shares (shares / 1024).
+	CpuRequestCores float64 `protobuf:"fixed64,6,opt,name=cpu_request_cores,json=cpuRequestCores,proto3" json:"cpu_request_cores,omitempty"`
+	// Memory limit in bytes, unset when unlimited.
+	MemoryLimitBytes *wrapperspb.UInt64Value `protobuf:"bytes,7,opt,name=memory_limit_bytes,json=memoryLimitBytes,proto3" json:"memory_limit_bytes,omitempty"`
+	// CPUs the container may run on (e.g., "0-3,8"), empty when not restricted.
+	CpusetCpus string `protobuf:"bytes,8,opt,name=cpuset_cpus,json=cpusetCpus,proto3" json:"cpuset_cpus,omitempty"`
+	// Memory nodes the container may allocate from (e.g., "0"), empty when not restricted.
+	CpusetMems    string `protobuf:"bytes,9,opt,name=cpuset_mems,json=cpusetMems,proto3" json:"cpuset_mems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerResources) Reset() {
+	*x = ContainerResources{}
+	mi := &file_proto_container_metrics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerResources) ProtoMessage() {}
+
+func (x *ContainerResources) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_container_metrics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerResources.ProtoReflect.Descriptor instead.
+func (*ContainerResources) Descriptor() ([]byte, []int) {
+	return file_proto_container_metrics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ContainerResources) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ContainerResources) GetCpuShares() uint64 {
+	if x != nil {
+		return x.CpuShares
+	}
+	return 0
+}
+
+func (x *ContainerResources) GetCpuQuotaUsec() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.CpuQuotaUsec
+	}
+	return nil
+}
+
+func (x *ContainerResources) GetCpuPeriodUsec() uint64 {
+	if x != nil {
+		return x.CpuPeriodUsec
+	}
+	return 0
+}
+
+func (x *ContainerResources) GetCpuLimitCores() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.CpuLimitCores
+	}
+	return nil
+}
+
+func (x *ContainerResources) GetCpuRequestCores() float64 {
+	if x != nil {
+		return x.CpuRequestCores
+	}
+	return 0
+}
+
+func (x *ContainerResources) GetMemoryLimitBytes() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.MemoryLimitBytes
+	}
+	return nil
+}
+
+func (x *ContainerResources) GetCpusetCpus() string {
+	if x != nil {
+		return x.CpusetCpus
+	}
+	return ""
+}
+
+func (x *ContainerResources) GetCpusetMems() string {
+	if x != nil {
+		return x.CpusetMems
+	}
+	return ""
+}
+
 var File_proto_container_metrics_proto protoreflect.FileDescriptor
 
 const file_proto_container_metrics_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/container_metrics.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\"\x95\x03\n" +
+	"\x1dproto/container_metrics.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x0fproto/psi.proto\"\x92\b\n" +
 	"\x10ContainerMetrics\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -469,12 +1263,28 @@ const file_proto_container_metrics_proto_rawDesc = "" +
 	"\x0ememory_metrics\x18\b \x01(\v2\x16.metrics.MemoryMetricsR\rmemoryMetrics\x12J\n" +
 	"\x13file_system_metrics\x18\t \x01(\v2\x1a.metrics.FileSystemMetricsR\x11fileSystemMetrics\x127\n" +
 	"\fswap_metrics\x18\n" +
-	" \x01(\v2\x14.metrics.SwapMetricsR\vswapMetrics\"\xc7\x01\n" +
+	" \x01(\v2\x14.metrics.SwapMetricsR\vswapMetrics\x123\n" +
+	"\bpressure\x18\v \x01(\v2\x17.metrics.CgroupPressureR\bpressure\x12=\n" +
+	"\x0ecgroup_metrics\x18\f \x01(\v2\x16.metrics.CgroupMetricsR\rcgroupMetrics\x129\n" +
+	"\tresources\x18\r \x01(\v2\x1b.metrics.ContainerResourcesR\tresources\x12H\n" +
+	"\x11cpu_limit_percent\x18\x0e \x01(\v2\x1c.google.protobuf.DoubleValueR\x0fcpuLimitPercent\x12N\n" +
+	"\x14memory_limit_percent\x18\x0f \x01(\v2\x1c.google.protobuf.DoubleValueR\x12memoryLimitPercent\x12=\n" +
+	"\x06labels\x18\x10 \x03(\v2%.metrics.ContainerMetrics.LabelsEntryR\x06labels\x12L\n" +
+	"\vannotations\x18\x11 \x03(\v2*.metrics.ContainerMetrics.AnnotationsEntryR\vannotations\x12*\n" +
+	"\x04exit\x18\x12 \x01(\v2\x16.metrics.ContainerExitR\x04exit\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x02\n" +
 	"\n" +
 	"CpuMetrics\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12F\n" +
 	"\x10usage_nano_cores\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x0eusageNanoCores\x12S\n" +
-	"\x17usage_core_nano_seconds\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x14usageCoreNanoSeconds\"\xc1\x03\n" +
+	"\x17usage_core_nano_seconds\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x14usageCoreNanoSeconds\x12=\n" +
+	"\vusage_cores\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\n" +
+	"usageCores\"\xc1\x03\n" +
 	"\rMemoryMetrics\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12H\n" +
 	"\x11working_set_bytes\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x0fworkingSetBytes\x12E\n" +
@@ -498,7 +1308,88 @@ const file_proto_container_metrics_proto_rawDesc = "" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12E\n" +
 	"\x0favailable_bytes\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x0eavailableBytes\x12=\n" +
 	"\vusage_bytes\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\n" +
-	"usageBytesB\fZ\n" +
+	"usageBytes\"\xb5\x03\n" +
+	"\rCgroupMetrics\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12)\n" +
+	"\x03cpu\x18\x04 \x01(\v2\x17.metrics.CgroupCpuStatsR\x03cpu\x122\n" +
+	"\x06memory\x18\x05 \x01(\v2\x1a.metrics.CgroupMemoryStatsR\x06memory\x12&\n" +
+	"\x02io\x18\x06 \x01(\v2\x16.metrics.CgroupIoStatsR\x02io\x12,\n" +
+	"\x04pids\x18\a \x01(\v2\x18.metrics.CgroupPidsStatsR\x04pids\x12]\n" +
+	"\x13hugetlb_usage_bytes\x18\b \x03(\v2-.metrics.CgroupMetrics.HugetlbUsageBytesEntryR\x11hugetlbUsageBytes\x1aD\n" +
+	"\x16HugetlbUsageBytesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\xb9\x02\n" +
+	"\x0eCgroupCpuStats\x12\x1d\n" +
+	"\n" +
+	"usage_usec\x18\x01 \x01(\x04R\tusageUsec\x12\x1b\n" +
+	"\tuser_usec\x18\x02 \x01(\x04R\buserUsec\x12\x1f\n" +
+	"\vsystem_usec\x18\x03 \x01(\x04R\n" +
+	"systemUsec\x12\x18\n" +
+	"\aperiods\x18\x04 \x01(\x04R\aperiods\x12+\n" +
+	"\x11throttled_periods\x18\x05 \x01(\x04R\x10throttledPeriods\x12%\n" +
+	"\x0ethrottled_usec\x18\x06 \x01(\x04R\rthrottledUsec\x12;\n" +
+	"\n" +
+	"quota_usec\x18\a \x01(\v2\x1c.google.protobuf.UInt64ValueR\tquotaUsec\x12\x1f\n" +
+	"\vperiod_usec\x18\b \x01(\x04R\n" +
+	"periodUsec\"\xba\x03\n" +
+	"\x11CgroupMemoryStats\x12\x1f\n" +
+	"\vusage_bytes\x18\x01 \x01(\x04R\n" +
+	"usageBytes\x12=\n" +
+	"\vlimit_bytes\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\n" +
+	"limitBytes\x12\x1d\n" +
+	"\n" +
+	"anon_bytes\x18\x03 \x01(\x04R\tanonBytes\x12\x1d\n" +
+	"\n" +
+	"file_bytes\x18\x04 \x01(\x04R\tfileBytes\x12!\n" +
+	"\fkernel_bytes\x18\x05 \x01(\x04R\vkernelBytes\x12\x1f\n" +
+	"\vshmem_bytes\x18\x06 \x01(\x04R\n" +
+	"shmemBytes\x12\x1d\n" +
+	"\n" +
+	"slab_bytes\x18\a \x01(\x04R\tslabBytes\x12\x1d\n" +
+	"\n" +
+	"sock_bytes\x18\b \x01(\x04R\tsockBytes\x12\x1f\n" +
+	"\vhigh_events\x18\t \x01(\x04R\n" +
+	"highEvents\x12\x1d\n" +
+	"\n" +
+	"max_events\x18\n" +
+	" \x01(\x04R\tmaxEvents\x12\x1d\n" +
+	"\n" +
+	"oom_events\x18\v \x01(\x04R\toomEvents\x12&\n" +
+	"\x0foom_kill_events\x18\f \x01(\x04R\roomKillEvents\"\x87\x01\n" +
+	"\rCgroupIoStats\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x01 \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\x02 \x01(\x04R\n" +
+	"writeBytes\x12\x19\n" +
+	"\bread_ops\x18\x03 \x01(\x04R\areadOps\x12\x1b\n" +
+	"\twrite_ops\x18\x04 \x01(\x04R\bwriteOps\"[\n" +
+	"\x0fCgroupPidsStats\x12\x18\n" +
+	"\acurrent\x18\x01 \x01(\x04R\acurrent\x12.\n" +
+	"\x03max\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x03max\"\xc3\x01\n" +
+	"\rContainerExit\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\x03R\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\x05 \x01(\x03R\n" +
+	"finishedAt\x12#\n" +
+	"\rrestart_count\x18\x06 \x01(\rR\frestartCount\"\xb7\x03\n" +
+	"\x12ContainerResources\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x1d\n" +
+	"\n" +
+	"cpu_shares\x18\x02 \x01(\x04R\tcpuShares\x12B\n" +
+	"\x0ecpu_quota_usec\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\fcpuQuotaUsec\x12&\n" +
+	"\x0fcpu_period_usec\x18\x04 \x01(\x04R\rcpuPeriodUsec\x12D\n" +
+	"\x0fcpu_limit_cores\x18\x05 \x01(\v2\x1c.google.protobuf.DoubleValueR\rcpuLimitCores\x12*\n" +
+	"\x11cpu_request_cores\x18\x06 \x01(\x01R\x0fcpuRequestCores\x12J\n" +
+	"\x12memory_limit_bytes\x18\a \x01(\v2\x1c.google.protobuf.UInt64ValueR\x10memoryLimitBytes\x12\x1f\n" +
+	"\vcpuset_cpus\x18\b \x01(\tR\n" +
+	"cpusetCpus\x12\x1f\n" +
+	"\vcpuset_mems\x18\t \x01(\tR\n" +
+	"cpusetMemsB\fZ\n" +
 	"/proto/genb\x06proto3"
 
 var (
@@ -513,37 +1404,69 @@ func file_proto_container_metrics_proto_rawDescGZIP() []byte {
 	return file_proto_container_metrics_proto_rawDescData
 }
 
-var file_proto_container_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_container_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_container_metrics_proto_goTypes = []any{
 	(*ContainerMetrics)(nil),       // 0: metrics.ContainerMetrics
 	(*CpuMetrics)(nil),             // 1: metrics.CpuMetrics
 	(*MemoryMetrics)(nil),          // 2: metrics.MemoryMetrics
 	(*FileSystemMetrics)(nil),      // 3: metrics.FileSystemMetrics
 	(*SwapMetrics)(nil),            // 4: metrics.SwapMetrics
-	(*wrapperspb.UInt64Value)(nil), // 5: google.protobuf.UInt64Value
+	(*CgroupMetrics)(nil),          // 5: metrics.CgroupMetrics
+	(*CgroupCpuStats)(nil),         // 6: metrics.CgroupCpuStats
+	(*CgroupMemoryStats)(nil),      // 7: metrics.CgroupMemoryStats
+	(*CgroupIoStats)(nil),          // 8: metrics.CgroupIoStats
+	(*CgroupPidsStats)(nil),        // 9: metrics.CgroupPidsStats
+	(*ContainerExit)(nil),          // 10: metrics.ContainerExit
+	(*ContainerResources)(nil),     // 11: metrics.ContainerResources
+	nil,                            // 12: metrics.ContainerMetrics.LabelsEntry
+	nil,                            // 13: metrics.ContainerMetrics.AnnotationsEntry
+	nil,                            // 14: metrics.CgroupMetrics.HugetlbUsageBytesEntry
+	(*CgroupPressure)(nil),         // 15: metrics.CgroupPressure
+	(*wrapperspb.DoubleValue)(nil), // 16: google.protobuf.DoubleValue
+	(*wrapperspb.UInt64Value)(nil), // 17: google.protobuf.UInt64Value
 }
 var file_proto_container_metrics_proto_depIdxs = []int32{
 	1,  // 0: metrics.ContainerMetrics.cpu_metrics:type_name -> metrics.CpuMetrics
 	2,  // 1: metrics.ContainerMetrics.memory_metrics:type_name -> metrics.MemoryMetrics
 	3,  // 2: metrics.ContainerMetrics.file_system_metrics:type_name -> metrics.FileSystemMetrics
 	4,  // 3: metrics.ContainerMetrics.swap_metrics:type_name -> metrics.SwapMetrics
-	5,  // 4: metrics.CpuMetrics.usage_nano_cores:type_name -> google.protobuf.UInt64Value
-	5,  // 5: metrics.CpuMetrics.usage_core_nano_seconds:type_name -> google.protobuf.UInt64Value
-	5,  // 6: metrics.MemoryMetrics.working_set_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 7: metrics.MemoryMetrics.available_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 8: metrics.MemoryMetrics.usage_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 9: metrics.MemoryMetrics.rss_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 10: metrics.MemoryMetrics.page_faults:type_name -> google.protobuf.UInt64Value
-	5,  // 11: metrics.MemoryMetrics.major_page_faults:type_name -> google.protobuf.UInt64Value
-	5,  // 12: metrics.FileSystemMetrics.used_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 13: metrics.FileSystemMetrics.inodes_used:type_name -> google.protobuf.UInt64Value
-	5,  // 14: metrics.SwapMetrics.available_bytes:type_name -> google.protobuf.UInt64Value
-	5,  // 15: metrics.SwapMetrics.usage_bytes:type_name -> google.protobuf.UInt64Value
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	15, // 4: metrics.ContainerMetrics.pressure:type_name -> metrics.CgroupPressure
+	5,  // 5: metrics.ContainerMetrics.cgroup_metrics:type_name -> metrics.CgroupMetrics
+	11, // 6: metrics.ContainerMetrics.resources:type_name -> metrics.ContainerResources
+	16, // 7: metrics.ContainerMetrics.cpu_limit_percent:type_name -> google.protobuf.DoubleValue
+	16, // 8: metrics.ContainerMetrics.memory_limit_percent:type_name -> google.protobuf.DoubleValue
+	12, // 9: metrics.ContainerMetrics.labels:type_name -> metrics.ContainerMetrics.LabelsEntry
+	13, // 10: metrics.ContainerMetrics.annotations:type_name -> metrics.ContainerMetrics.AnnotationsEntry
+	10, // 11: metrics.ContainerMetrics.exit:type_name -> metrics.ContainerExit
+	17, // 12: metrics.CpuMetrics.usage_nano_cores:type_name -> google.protobuf.UInt64Value
+	17, // 13: metrics.CpuMetrics.usage_core_nano_seconds:type_name -> google.protobuf.UInt64Value
+	16, // 14: metrics.CpuMetrics.usage_cores:type_name -> google.protobuf.DoubleValue
+	17, // 15: metrics.MemoryMetrics.working_set_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 16: metrics.MemoryMetrics.available_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 17: metrics.MemoryMetrics.usage_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 18: metrics.MemoryMetrics.rss_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 19: metrics.MemoryMetrics.page_faults:type_name -> google.protobuf.UInt64Value
+	17, // 20: metrics.MemoryMetrics.major_page_faults:type_name -> google.protobuf.UInt64Value
+	17, // 21: metrics.FileSystemMetrics.used_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 22: metrics.FileSystemMetrics.inodes_used:type_name -> google.protobuf.UInt64Value
+	17, // 23: metrics.SwapMetrics.available_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 24: metrics.SwapMetrics.usage_bytes:type_name -> google.protobuf.UInt64Value
+	6,  // 25: metrics.CgroupMetrics.cpu:type_name -> metrics.CgroupCpuStats
+	7,  // 26: metrics.CgroupMetrics.memory:type_name -> metrics.CgroupMemoryStats
+	8,  // 27: metrics.CgroupMetrics.io:type_name -> metrics.CgroupIoStats
+	9,  // 28: metrics.CgroupMetrics.pids:type_name -> metrics.CgroupPidsStats
+	14, // 29: metrics.CgroupMetrics.hugetlb_usage_bytes:type_name -> metrics.CgroupMetrics.HugetlbUsageBytesEntry
+	17, // 30: metrics.CgroupCpuStats.quota_usec:type_name -> google.protobuf.UInt64Value
+	17, // 31: metrics.CgroupMemoryStats.limit_bytes:type_name -> google.protobuf.UInt64Value
+	17, // 32: metrics.CgroupPidsStats.max:type_name -> google.protobuf.UInt64Value
+	17, // 33: metrics.ContainerResources.cpu_quota_usec:type_name -> google.protobuf.UInt64Value
+	16, // 34: metrics.ContainerResources.cpu_limit_cores:type_name -> google.protobuf.DoubleValue
+	17, // 35: metrics.ContainerResources.memory_limit_bytes:type_name -> google.protobuf.UInt64Value
+	36, // [36:36] is the sub-list for method output_type
+	36, // [36:36] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_proto_container_metrics_proto_init() }
@@ -551,13 +1474,14 @@ func file_proto_container_metrics_proto_init() {
 	if File_proto_container_metrics_proto != nil {
 		return
 	}
+	file_proto_psi_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_container_metrics_proto_rawDesc), len(file_proto_container_metrics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,9 +32,17 @@ type Metrics struct {
 	// System-level metrics for the current node.
 	NodeMetrics *NodeMetrics `protobuf:"bytes,2,opt,name=node_metrics,json=nodeMetrics,proto3" json:"node_metrics,omitempty"`
 	// Runtime metrics for all pods and their containers scheduled on this node.
-	PodMetrics    []*PodMetrics `protobuf:"bytes,3,rep,name=pod_metrics,json=podMetrics,proto3" json:"pod_metrics,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PodMetrics []*PodMetrics `protobuf:"bytes,3,rep,name=pod_metrics,json=podMetrics,proto3" json:"pod_metrics,omitempty"`
+	// Self-telemetry of the agent for the collection cycle that produced this snapshot.
+	AgentStats *AgentStats `protobuf:"bytes,4,opt,name=agent_stats,json=agentStats,proto3" json:"agent_stats,omitempty"`
+	// Kernel events of the node (OOM kills, hung tasks, lockups, filesystem errors, link flaps)
+	// that occurred since the previous snapshot.
+	NodeEvents []*NodeEvent `protobuf:"bytes,5,rep,name=node_events,json=nodeEvents,proto3" json:"node_events,omitempty"`
+	// Container lifecycle events (created, started, stopped, deleted) that occurred since the previous snapshot,
+	// including containers too short-lived to be seen by a collection cycle.
+	ContainerEvents []*ContainerEvent `protobuf:"bytes,6,rep,name=container_events,json=containerEvents,proto3" json:"container_events,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Metrics) Reset() {
@@ -88,16 +96,42 @@ func (x *Metrics) GetPodMetrics() []*PodMetrics {
 	return nil
 }
 
+func (x *Metrics) GetAgentStats() *AgentStats {
+	if x != nil {
+		return x.AgentStats
+	}
+	return nil
+}
+
+func (x *Metrics) GetNodeEvents() []*NodeEvent {
+	if x != nil {
+		return x.NodeEvents
+	}
+	return nil
+}
+
+func (x *Metrics) GetContainerEvents() []*ContainerEvent {
+	if x != nil {
+		return x.ContainerEvents
+	}
+	return nil
+}
+
 var File_proto_metrics_proto protoreflect.FileDescriptor
 
 const file_proto_metrics_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/metrics.proto\x12\ametrics\x1a\x1bgoogle/protobuf/empty.proto\x1a\x18proto/node_metrics.proto\x1a\x17proto/pod_metrics.proto\"\x96\x01\n" +
+	"\x13proto/metrics.proto\x12\ametrics\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17proto/agent_stats.proto\x1a\x1bproto/container_event.proto\x1a\x16proto/node_event.proto\x1a\x18proto/node_metrics.proto\x1a\x17proto/pod_metrics.proto\"\xc5\x02\n" +
 	"\aMetrics\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x127\n" +
 	"\fnode_metrics\x18\x02 \x01(\v2\x14.metrics.NodeMetricsR\vnodeMetrics\x124\n" +
 	"\vpod_metrics\x18\x03 \x03(\v2\x13.metrics.PodMetricsR\n" +
-	"podMetrics2\x8b\x01\n" +
+	"podMetrics\x124\n" +
+	"\vagent_stats\x18\x04 \x01(\v2\x13.metrics.AgentStatsR\n" +
+	"agentStats\x123\n" +
+	"\vnode_events\x18\x05 \x03(\v2\x12.metrics.NodeEventR\n" +
+	"nodeEvents\x12B\n" +
+	"\x10container_events\x18\x06 \x03(\v2\x17.metrics.ContainerEventR\x0fcontainerEvents2\x8b\x01\n" +
 	"\x0eMetricsService\x129\n" +
 	"\vSendMetrics\x12\x10.metrics.Metrics\x1a\x16.google.protobuf.Empty(\x01\x12>\n" +
 	"\x10SubscribeMetrics\x12\x16.google.protobuf.Empty\x1a\x10.metrics.Metrics0\x01B\fZ\n" +
@@ -117,23 +151,29 @@ func file_proto_metrics_proto_rawDescGZIP() []byte {
 
 var file_proto_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_proto_metrics_proto_goTypes = []any{
-	(*Metrics)(nil),       // 0: metrics.Metrics
-	(*NodeMetrics)(nil),   // 1: metrics.NodeMetrics
-	(*PodMetrics)(nil),    // 2: metrics.PodMetrics
-	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
+	(*Metrics)(nil),        // 0: metrics.Metrics
+	(*NodeMetrics)(nil),    // 1: metrics.NodeMetrics
+	(*PodMetrics)(nil),     // 2: metrics.PodMetrics
+	(*AgentStats)(nil),     // 3: metrics.AgentStats
+	(*NodeEvent)(nil),      // 4: metrics.NodeEvent
+	(*ContainerEvent)(nil), // 5: metrics.ContainerEvent
+	(*emptypb.Empty)(nil),  // 6: google.protobuf.Empty
 }
 var file_proto_metrics_proto_depIdxs = []int32{
 	1, // 0: metrics.Metrics.node_metrics:type_name -> metrics.NodeMetrics
 	2, // 1: metrics.Metrics.pod_metrics:type_name -> metrics.PodMetrics
-	0, // 2: metrics.MetricsService.SendMetrics:input_type -> metrics.Metrics
-	3, // 3: metrics.MetricsService.SubscribeMetrics:input_type -> google.protobuf.Empty
-	3, // 4: metrics.MetricsService.SendMetrics:output_type -> google.protobuf.Empty
-	0, // 5: metrics.MetricsService.SubscribeMetrics:output_type -> metrics.Metrics
-	4, // [4:6] is the sub-list for method output_type
-	2, // [2:4] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: metrics.Metrics.agent_stats:type_name -> metrics.AgentStats
+	4, // 3: metrics.Metrics.node_events:type_name -> metrics.NodeEvent
+	5, // 4: metrics.Metrics.container_events:type_name -> metrics.ContainerEvent
+	0, // 5: metrics.MetricsService.SendMetrics:input_type -> metrics.Metrics
+	6, // 6: metrics.MetricsService.SubscribeMetrics:input_type -> google.protobuf.Empty
+	6, // 7: metrics.MetricsService.SendMetrics:output_type -> google.protobuf.Empty
+	0, // 8: metrics.MetricsService.SubscribeMetrics:output_type -> metrics.Metrics
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_proto_metrics_proto_init() }
@@ -141,6 +181,9 @@ func file_proto_metrics_proto_init() {
 	if File_proto_metrics_proto != nil {
 		return
 	}
+	file_proto_agent_stats_proto_init()
+	file_proto_container_event_proto_init()
+	file_proto_node_event_proto_init()
 	file_proto_node_metrics_proto_init()
 	file_proto_pod_metrics_proto_init()
 	type x struct{}
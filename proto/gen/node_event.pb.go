@@ -0,0 +1,250 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: proto/node_event.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NodeEvent is a kernel event of the node, parsed from the kernel log (/dev/kmsg) or reported
+// by a PSI trigger.
+// Events are reported once, in the first snapshot collected after they occurred.
+type NodeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of event: "oom_kill", "hung_task", "soft_lockup", "filesystem_error", "link_down", "link_up"
+	// or "psi_trigger".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Time of the event in nanoseconds since epoch.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Raw kernel log message, truncated to a bounded length.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Syslog priority of the message (0 emergency to 7 debug).
+	Priority uint32 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// PID of the process involved (OOM victim, hung or locked-up task), 0 if none.
+	Pid int32 `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Name of the process involved, empty if none.
+	ProcessName string `protobuf:"bytes,6,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	// Cgroup of the process involved (e.g., the memory cgroup of an OOM victim), empty if unknown.
+	Cgroup string `protobuf:"bytes,7,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	// UID of the pod owning the process (matches PodMetrics.uid), empty if not in a pod.
+	PodUid string `protobuf:"bytes,8,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	// ID of the container owning the process (matches ContainerMetrics.id), empty if not in a container.
+	ContainerId string `protobuf:"bytes,9,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Device involved: block device of a filesystem error or network interface of a link flap.
+	Device string `protobuf:"bytes,10,opt,name=device,proto3" json:"device,omitempty"`
+	// Number of occurrences represented by the event. Always 1 for kernel log events; the number of
+	// OOM kills since the previous snapshot when the kernel log is unavailable and the /proc/vmstat
+	// oom_kill counter is used instead (without process or pod attribution).
+	Count uint64 `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+	// Additional details depending on the type (e.g., "constraint" and "anon_rss_bytes" for OOM kills,
+	// "blocked_seconds" for hung tasks, "cpu" and "stuck_seconds" for soft lockups, "resource", "kind",
+	// "stall_us", "window_us" and "avg10" for PSI triggers).
+	Attributes    map[string]string `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeEvent) Reset() {
+	*x = NodeEvent{}
+	mi := &file_proto_node_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEvent) ProtoMessage() {}
+
+func (x *NodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEvent.ProtoReflect.Descriptor instead.
+func (*NodeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_node_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NodeEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetCgroup() string {
+	if x != nil {
+		return x.Cgroup
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetPodUid() string {
+	if x != nil {
+		return x.PodUid
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *NodeEvent) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NodeEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+var File_proto_node_event_proto protoreflect.FileDescriptor
+
+const file_proto_node_event_proto_rawDesc = "" +
+	"\n" +
+	"\x16proto/node_event.proto\x12\ametrics\"\xad\x03\n" +
+	"\tNodeEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1c\n" +
+	"\ttimestamp\x18\x02 \x01(\x03R\ttimestamp\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\rR\bpriority\x12\x10\n" +
+	"\x03pid\x18\x05 \x01(\x05R\x03pid\x12!\n" +
+	"\fprocess_name\x18\x06 \x01(\tR\vprocessName\x12\x16\n" +
+	"\x06cgroup\x18\a \x01(\tR\x06cgroup\x12\x17\n" +
+	"\apod_uid\x18\b \x01(\tR\x06podUid\x12!\n" +
+	"\fcontainer_id\x18\t \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06device\x18\n" +
+	" \x01(\tR\x06device\x12\x14\n" +
+	"\x05count\x18\v \x01(\x04R\x05count\x12B\n" +
+	"\n" +
+	"attributes\x18\f \x03(\v2\".metrics.NodeEvent.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\fZ\n" +
+	"/proto/genb\x06proto3"
+
+var (
+	file_proto_node_event_proto_rawDescOnce sync.Once
+	file_proto_node_event_proto_rawDescData []byte
+)
+
+func file_proto_node_event_proto_rawDescGZIP() []byte {
+	file_proto_node_event_proto_rawDescOnce.Do(func() {
+		file_proto_node_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_node_event_proto_rawDesc), len(file_proto_node_event_proto_rawDesc)))
+	})
+	return file_proto_node_event_proto_rawDescData
+}
+
+var file_proto_node_event_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_node_event_proto_goTypes = []any{
+	(*NodeEvent)(nil), // 0: metrics.NodeEvent
+	nil,               // 1: metrics.NodeEvent.AttributesEntry
+}
+var file_proto_node_event_proto_depIdxs = []int32{
+	1, // 0: metrics.NodeEvent.attributes:type_name -> metrics.NodeEvent.AttributesEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_node_event_proto_init() }
+func file_proto_node_event_proto_init() {
+	if File_proto_node_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_node_event_proto_rawDesc), len(file_proto_node_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_node_event_proto_goTypes,
+		DependencyIndexes: file_proto_node_event_proto_depIdxs,
+		MessageInfos:      file_proto_node_event_proto_msgTypes,
+	}.Build()
+	File_proto_node_event_proto = out.File
+	file_proto_node_event_proto_goTypes = nil
+	file_proto_node_event_proto_depIdxs = nil
+}
@@ -54,6 +54,7 @@ type NodeMetrics struct {
 	// Unique identifier for the host (as reported by the system, usually from DMI or machine-id).
 	HostId string `protobuf:"bytes,14,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`
 	// Aggregated CPU usage percentage across all logical CPUs over the last sampling interval.
+	// The interval is the time elapsed since the previous collection cycle.
 	TotalCpuPercentage float64 `protobuf:"fixed64,15,opt,name=total_cpu_percentage,json=totalCpuPercentage,proto3" json:"total_cpu_percentage,omitempty"`
 	// Detailed metrics and metadata for each logical CPU on the node.
 	CpuInfos []*CpuInfo `protobuf:"bytes,16,rep,name=cpu_infos,json=cpuInfos,proto3" json:"cpu_infos,omitempty"`
@@ -83,8 +84,34 @@ type NodeMetrics struct {
 	PsiIoMetrics *PsiMetrics `protobuf:"bytes,28,opt,name=psi_io_metrics,json=psiIoMetrics,proto3" json:"psi_io_metrics,omitempty"`
 	// List of all network interfaces present on the node, including their metadata and IPs.
 	NetworkInterfaces []*InterfaceStat `protobuf:"bytes,29,rep,name=network_interfaces,json=networkInterfaces,proto3" json:"network_interfaces,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Breakdown of the aggregated CPU time across all logical CPUs by mode over the last sampling interval.
+	TotalCpuTimes *CpuTimes `protobuf:"bytes,30,opt,name=total_cpu_times,json=totalCpuTimes,proto3" json:"total_cpu_times,omitempty"`
+	// Load average, run queue, context switch, fork and interrupt statistics.
+	LoadMetrics *LoadMetrics `protobuf:"bytes,31,opt,name=load_metrics,json=loadMetrics,proto3" json:"load_metrics,omitempty"`
+	// Detailed memory breakdown from /proc/meminfo and paging activity from /proc/vmstat.
+	MemoryMetrics *NodeMemoryMetrics `protobuf:"bytes,32,opt,name=memory_metrics,json=memoryMetrics,proto3" json:"memory_metrics,omitempty"`
+	// Per-block-device I/O statistics, partitions included.
+	DiskIoStats []*DiskIOStats `protobuf:"bytes,33,rep,name=disk_io_stats,json=diskIoStats,proto3" json:"disk_io_stats,omitempty"`
+	// Top N processes by CPU usage over the last collection interval.
+	TopCpuProcesses []*ProcessStats `protobuf:"bytes,34,rep,name=top_cpu_processes,json=topCpuProcesses,proto3" json:"top_cpu_processes,omitempty"`
+	// Top N processes by resident memory (RSS).
+	TopMemoryProcesses []*ProcessStats `protobuf:"bytes,35,rep,name=top_memory_processes,json=topMemoryProcesses,proto3" json:"top_memory_processes,omitempty"`
+	// TCP and UDP stack health from /proc/net/snmp, /proc/net/netstat and /proc/net/sockstat(6).
+	NetworkStackMetrics *NetworkStackMetrics `protobuf:"bytes,36,opt,name=network_stack_metrics,json=networkStackMetrics,proto3" json:"network_stack_metrics,omitempty"`
+	// Connection tracking table usage and netfilter pressure, unset when the conntrack module is not loaded.
+	ConntrackMetrics *ConntrackMetrics `protobuf:"bytes,37,opt,name=conntrack_metrics,json=conntrackMetrics,proto3" json:"conntrack_metrics,omitempty"`
+	// Usage of kernel resource limits (file handles, PIDs, threads, inotify, kubepods pids).
+	KernelLimits *KernelLimits `protobuf:"bytes,38,opt,name=kernel_limits,json=kernelLimits,proto3" json:"kernel_limits,omitempty"`
+	// Pressure stall information for time spent servicing hard and soft interrupts (only "full" is reported).
+	// Unset when the kernel does not account IRQ pressure (Linux < 6.1 or CONFIG_IRQ_TIME_ACCOUNTING disabled).
+	PsiIrqMetrics *PsiMetrics `protobuf:"bytes,39,opt,name=psi_irq_metrics,json=psiIrqMetrics,proto3" json:"psi_irq_metrics,omitempty"`
+	// Whether the kernel exposes node-wide PSI (/proc/pressure). When false, the psi_* fields are unset.
+	PsiSupported bool `protobuf:"varint,40,opt,name=psi_supported,json=psiSupported,proto3" json:"psi_supported,omitempty"`
+	// Pressure of the kubelet QoS cgroups, keyed by tier: "kubepods" (all pods, the Guaranteed pods live
+	// directly under it), "burstable" and "besteffort". Empty when per-cgroup PSI is unavailable (cgroup v1).
+	QosPressure   map[string]*CgroupPressure `protobuf:"bytes,41,rep,name=qos_pressure,json=qosPressure,proto3" json:"qos_pressure,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *NodeMetrics) Reset() {
@@ -306,110 +333,181 @@ func (x *NodeMetrics) GetNetworkInterfaces() []*InterfaceStat {
 	return nil
 }
 
-// ProcessMemInfo represents basic memory usage statistics for a single process.
-// Used to report the most memory-intensive processes on the node.
-type ProcessMemInfo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Process ID (PID).
-	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
-	// Executable name of the process.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Resident memory usage in bytes (RSS).
-	Memory        uint64 `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NodeMetrics) GetTotalCpuTimes() *CpuTimes {
+	if x != nil {
+		return x.TotalCpuTimes
+	}
+	return nil
 }
 
-func (x *ProcessMemInfo) Reset() {
-	*x = ProcessMemInfo{}
-	mi := &file_proto_node_metrics_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NodeMetrics) GetLoadMetrics() *LoadMetrics {
+	if x != nil {
+		return x.LoadMetrics
+	}
+	return nil
 }
 
-func (x *ProcessMemInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeMetrics) GetMemoryMetrics() *NodeMemoryMetrics {
+	if x != nil {
+		return x.MemoryMetrics
+	}
+	return nil
 }
 
-func (*ProcessMemInfo) ProtoMessage() {}
+func (x *NodeMetrics) GetDiskIoStats() []*DiskIOStats {
+	if x != nil {
+		return x.DiskIoStats
+	}
+	return nil
+}
 
-func (x *ProcessMemInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[1]
+func (x *NodeMetrics) GetTopCpuProcesses() []*ProcessStats {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TopCpuProcesses
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ProcessMemInfo.ProtoReflect.Descriptor instead.
-func (*ProcessMemInfo) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{1}
+func (x *NodeMetrics) GetTopMemoryProcesses() []*ProcessStats {
+	if x != nil {
+		return x.TopMemoryProcesses
+	}
+	return nil
 }
 
-func (x *ProcessMemInfo) GetPid() int32 {
+func (x *NodeMetrics) GetNetworkStackMetrics() *NetworkStackMetrics {
 	if x != nil {
-		return x.Pid
+		return x.NetworkStackMetrics
 	}
-	return 0
+	return nil
 }
 
-func (x *ProcessMemInfo) GetName() string {
+func (x *NodeMetrics) GetConntrackMetrics() *ConntrackMetrics {
 	if x != nil {
-		return x.Name
+		return x.ConntrackMetrics
 	}
-	return ""
+	return nil
 }
 
-func (x *ProcessMemInfo) GetMemory() uint64 {
+func (x *NodeMetrics) GetKernelLimits() *KernelLimits {
 	if x != nil {
-		return x.Memory
+		return x.KernelLimits
 	}
-	return 0
+	return nil
 }
 
-// CpuInfo provides metadata and real-time usage for a single logical CPU (hardware thread).
-// Logical CPUs are grouped into cores and physical sockets (CPUs).
-type CpuInfo struct {
+func (x *NodeMetrics) GetPsiIrqMetrics() *PsiMetrics {
+	if x != nil {
+		return x.PsiIrqMetrics
+	}
+	return nil
+}
+
+func (x *NodeMetrics) GetPsiSupported() bool {
+	if x != nil {
+		return x.PsiSupported
+	}
+	return false
+}
+
+func (x *NodeMetrics) GetQosPressure() map[string]*CgroupPressure {
+	if x != nil {
+		return x.QosPressure
+	}
+	return nil
+}
+
+// NodeMemoryMetrics breaks down node memory usage (from /proc/meminfo) and reports paging
+// and reclaim activity (from /proc/vmstat). It tells whether page cache or anonymous memory
+// is growing, and whether the kernel is struggling to reclaim memory.
+// Rates are computed over the interval elapsed since the previous collection cycle.
+type NodeMemoryMetrics struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Model name of the processor (e.g., "Intel(R) Core(TM) i9-10900K CPU @ 3.70GHz").
-	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
-	// Number of physical cores reported for the CPU. May be duplicated across logical threads.
-	Cores int32 `protobuf:"varint,2,opt,name=cores,proto3" json:"cores,omitempty"`
-	// Clock speed in MHz.
-	Mhz int32 `protobuf:"varint,3,opt,name=mhz,proto3" json:"mhz,omitempty"`
-	// Vendor identifier (e.g., "GenuineIntel", "AuthenticAMD").
-	VendorId string `protobuf:"bytes,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
-	// Identifier of the physical CPU socket.
-	PhysicalId string `protobuf:"bytes,5,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
-	// Identifier of the core within the physical socket.
-	CoreId string `protobuf:"bytes,6,opt,name=core_id,json=coreId,proto3" json:"core_id,omitempty"`
-	// Logical CPU/thread ID as reported by the OS.
-	Cpu int32 `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	// Percentage of time this logical CPU was actively executing instructions over the sampling interval (e.g., 1s).
-	Usage         float64 `protobuf:"fixed64,8,opt,name=usage,proto3" json:"usage,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Memory not used at all (MemFree), in bytes.
+	FreeBytes uint64 `protobuf:"varint,1,opt,name=free_bytes,json=freeBytes,proto3" json:"free_bytes,omitempty"`
+	// Memory used by block device buffers (Buffers), in bytes.
+	BuffersBytes uint64 `protobuf:"varint,2,opt,name=buffers_bytes,json=buffersBytes,proto3" json:"buffers_bytes,omitempty"`
+	// Page cache, excluding swap cache (Cached), in bytes.
+	CachedBytes uint64 `protobuf:"varint,3,opt,name=cached_bytes,json=cachedBytes,proto3" json:"cached_bytes,omitempty"`
+	// Shared memory and tmpfs (Shmem), in bytes. Counted in cached_bytes but not reclaimable.
+	ShmemBytes uint64 `protobuf:"varint,4,opt,name=shmem_bytes,json=shmemBytes,proto3" json:"shmem_bytes,omitempty"`
+	// Reclaimable kernel slab memory (SReclaimable), in bytes.
+	SlabReclaimableBytes uint64 `protobuf:"varint,5,opt,name=slab_reclaimable_bytes,json=slabReclaimableBytes,proto3" json:"slab_reclaimable_bytes,omitempty"`
+	// Unreclaimable kernel slab memory (SUnreclaim), in bytes.
+	SlabUnreclaimableBytes uint64 `protobuf:"varint,6,opt,name=slab_unreclaimable_bytes,json=slabUnreclaimableBytes,proto3" json:"slab_unreclaimable_bytes,omitempty"`
+	// Anonymous memory mapped into user space (AnonPages), in bytes.
+	AnonBytes uint64 `protobuf:"varint,7,opt,name=anon_bytes,json=anonBytes,proto3" json:"anon_bytes,omitempty"`
+	// Anonymous memory backed by transparent huge pages (AnonHugePages), in bytes.
+	AnonHugePagesBytes uint64 `protobuf:"varint,8,opt,name=anon_huge_pages_bytes,json=anonHugePagesBytes,proto3" json:"anon_huge_pages_bytes,omitempty"`
+	// Memory waiting to be written back to disk (Dirty), in bytes.
+	DirtyBytes uint64 `protobuf:"varint,9,opt,name=dirty_bytes,json=dirtyBytes,proto3" json:"dirty_bytes,omitempty"`
+	// Memory actively being written back to disk (Writeback), in bytes.
+	WritebackBytes uint64 `protobuf:"varint,10,opt,name=writeback_bytes,json=writebackBytes,proto3" json:"writeback_bytes,omitempty"`
+	// Memory committed by all processes, even if not yet used (Committed_AS), in bytes.
+	CommittedAsBytes uint64 `protobuf:"varint,11,opt,name=committed_as_bytes,json=committedAsBytes,proto3" json:"committed_as_bytes,omitempty"`
+	// Commit limit under strict overcommit accounting (CommitLimit), in bytes.
+	CommitLimitBytes uint64 `protobuf:"varint,12,opt,name=commit_limit_bytes,json=commitLimitBytes,proto3" json:"commit_limit_bytes,omitempty"`
+	// Memory used by page tables (PageTables), in bytes.
+	PageTablesBytes uint64 `protobuf:"varint,13,opt,name=page_tables_bytes,json=pageTablesBytes,proto3" json:"page_tables_bytes,omitempty"`
+	// Total number of pre-allocated huge pages (HugePages_Total).
+	HugePagesTotal uint64 `protobuf:"varint,14,opt,name=huge_pages_total,json=hugePagesTotal,proto3" json:"huge_pages_total,omitempty"`
+	// Number of huge pages not yet allocated (HugePages_Free).
+	HugePagesFree uint64 `protobuf:"varint,15,opt,name=huge_pages_free,json=hugePagesFree,proto3" json:"huge_pages_free,omitempty"`
+	// Number of huge pages reserved but not yet allocated (HugePages_Rsvd).
+	HugePagesReserved uint64 `protobuf:"varint,16,opt,name=huge_pages_reserved,json=hugePagesReserved,proto3" json:"huge_pages_reserved,omitempty"`
+	// Size of a huge page (Hugepagesize), in bytes.
+	HugePageSizeBytes uint64 `protobuf:"varint,17,opt,name=huge_page_size_bytes,json=hugePageSizeBytes,proto3" json:"huge_page_size_bytes,omitempty"`
+	// Total swap space (SwapTotal), in bytes.
+	SwapTotalBytes uint64 `protobuf:"varint,18,opt,name=swap_total_bytes,json=swapTotalBytes,proto3" json:"swap_total_bytes,omitempty"`
+	// Unused swap space (SwapFree), in bytes.
+	SwapFreeBytes uint64 `protobuf:"varint,19,opt,name=swap_free_bytes,json=swapFreeBytes,proto3" json:"swap_free_bytes,omitempty"`
+	// Swapped-out memory also present in RAM (SwapCached), in bytes.
+	SwapCachedBytes uint64 `protobuf:"varint,20,opt,name=swap_cached_bytes,json=swapCachedBytes,proto3" json:"swap_cached_bytes,omitempty"`
+	// Cumulative pages swapped in since boot (pswpin).
+	SwapInPages uint64 `protobuf:"varint,21,opt,name=swap_in_pages,json=swapInPages,proto3" json:"swap_in_pages,omitempty"`
+	// Pages swapped in per second.
+	SwapInPagesPerSecond float64 `protobuf:"fixed64,22,opt,name=swap_in_pages_per_second,json=swapInPagesPerSecond,proto3" json:"swap_in_pages_per_second,omitempty"`
+	// Cumulative pages swapped out since boot (pswpout).
+	SwapOutPages uint64 `protobuf:"varint,23,opt,name=swap_out_pages,json=swapOutPages,proto3" json:"swap_out_pages,omitempty"`
+	// Pages swapped out per second.
+	SwapOutPagesPerSecond float64 `protobuf:"fixed64,24,opt,name=swap_out_pages_per_second,json=swapOutPagesPerSecond,proto3" json:"swap_out_pages_per_second,omitempty"`
+	// Cumulative major page faults (requiring I/O) since boot (pgmajfault).
+	MajorPageFaults uint64 `protobuf:"varint,25,opt,name=major_page_faults,json=majorPageFaults,proto3" json:"major_page_faults,omitempty"`
+	// Major page faults per second.
+	MajorPageFaultsPerSecond float64 `protobuf:"fixed64,26,opt,name=major_page_faults_per_second,json=majorPageFaultsPerSecond,proto3" json:"major_page_faults_per_second,omitempty"`
+	// Cumulative pages scanned for reclaim since boot, by kswapd and direct reclaim (pgscan_*).
+	PagesScanned uint64 `protobuf:"varint,27,opt,name=pages_scanned,json=pagesScanned,proto3" json:"pages_scanned,omitempty"`
+	// Pages scanned for reclaim per second.
+	PagesScannedPerSecond float64 `protobuf:"fixed64,28,opt,name=pages_scanned_per_second,json=pagesScannedPerSecond,proto3" json:"pages_scanned_per_second,omitempty"`
+	// Pages scanned per second by direct reclaim only (pgscan_direct), which stalls allocating tasks.
+	PagesScannedDirectPerSecond float64 `protobuf:"fixed64,29,opt,name=pages_scanned_direct_per_second,json=pagesScannedDirectPerSecond,proto3" json:"pages_scanned_direct_per_second,omitempty"`
+	// Cumulative pages reclaimed since boot, by kswapd and direct reclaim (pgsteal_*).
+	PagesStolen uint64 `protobuf:"varint,30,opt,name=pages_stolen,json=pagesStolen,proto3" json:"pages_stolen,omitempty"`
+	// Pages reclaimed per second.
+	PagesStolenPerSecond float64 `protobuf:"fixed64,31,opt,name=pages_stolen_per_second,json=pagesStolenPerSecond,proto3" json:"pages_stolen_per_second,omitempty"`
+	// Cumulative number of OOM kills since boot (oom_kill).
+	OomKills uint64 `protobuf:"varint,32,opt,name=oom_kills,json=oomKills,proto3" json:"oom_kills,omitempty"`
+	// OOM kills per second.
+	OomKillsPerSecond float64 `protobuf:"fixed64,33,opt,name=oom_kills_per_second,json=oomKillsPerSecond,proto3" json:"oom_kills_per_second,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CpuInfo) Reset() {
-	*x = CpuInfo{}
-	mi := &file_proto_node_metrics_proto_msgTypes[2]
+func (x *NodeMemoryMetrics) Reset() {
+	*x = NodeMemoryMetrics{}
+	mi := &file_proto_node_metrics_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CpuInfo) String() string {
+func (x *NodeMemoryMetrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CpuInfo) ProtoMessage() {}
+func (*NodeMemoryMetrics) ProtoMessage() {}
 
-func (x *CpuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[2]
+func (x *NodeMemoryMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,326 +518,2204 @@ func (x *CpuInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CpuInfo.ProtoReflect.Descriptor instead.
-func (*CpuInfo) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use NodeMemoryMetrics.ProtoReflect.Descriptor instead.
+func (*NodeMemoryMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CpuInfo) GetModel() string {
+func (x *NodeMemoryMetrics) GetFreeBytes() uint64 {
 	if x != nil {
-		return x.Model
+		return x.FreeBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *CpuInfo) GetCores() int32 {
+func (x *NodeMemoryMetrics) GetBuffersBytes() uint64 {
 	if x != nil {
-		return x.Cores
+		return x.BuffersBytes
 	}
 	return 0
 }
 
-func (x *CpuInfo) GetMhz() int32 {
+func (x *NodeMemoryMetrics) GetCachedBytes() uint64 {
 	if x != nil {
-		return x.Mhz
+		return x.CachedBytes
 	}
 	return 0
 }
 
-func (x *CpuInfo) GetVendorId() string {
+func (x *NodeMemoryMetrics) GetShmemBytes() uint64 {
 	if x != nil {
-		return x.VendorId
+		return x.ShmemBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *CpuInfo) GetPhysicalId() string {
+func (x *NodeMemoryMetrics) GetSlabReclaimableBytes() uint64 {
 	if x != nil {
-		return x.PhysicalId
+		return x.SlabReclaimableBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *CpuInfo) GetCoreId() string {
+func (x *NodeMemoryMetrics) GetSlabUnreclaimableBytes() uint64 {
 	if x != nil {
-		return x.CoreId
+		return x.SlabUnreclaimableBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *CpuInfo) GetCpu() int32 {
+func (x *NodeMemoryMetrics) GetAnonBytes() uint64 {
 	if x != nil {
-		return x.Cpu
+		return x.AnonBytes
 	}
 	return 0
 }
 
-func (x *CpuInfo) GetUsage() float64 {
+func (x *NodeMemoryMetrics) GetAnonHugePagesBytes() uint64 {
 	if x != nil {
-		return x.Usage
+		return x.AnonHugePagesBytes
 	}
 	return 0
 }
 
-// NetUsage aggregates cumulative network statistics across all interfaces.
-// Useful for identifying total traffic, errors, and dropped packets on the node.
-type NetUsage struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total number of bytes sent.
-	TotalBytesSent uint64 `protobuf:"varint,1,opt,name=total_bytes_sent,json=totalBytesSent,proto3" json:"total_bytes_sent,omitempty"`
-	// Total number of bytes received.
-	TotalBytesReceived uint64 `protobuf:"varint,2,opt,name=total_bytes_received,json=totalBytesReceived,proto3" json:"total_bytes_received,omitempty"`
-	// Total number of packets sent.
-	TotalPacketsSent uint64 `protobuf:"varint,3,opt,name=total_packets_sent,json=totalPacketsSent,proto3" json:"total_packets_sent,omitempty"`
-	// Total number of packets received.
-	TotalPacketsReceived uint64 `protobuf:"varint,4,opt,name=total_packets_received,json=totalPacketsReceived,proto3" json:"total_packets_received,omitempty"`
-	// Total inbound packet errors.
-	TotalErrIn uint64 `protobuf:"varint,5,opt,name=total_err_in,json=totalErrIn,proto3" json:"total_err_in,omitempty"`
-	// Total outbound packet errors.
-	TotalErrOut uint64 `protobuf:"varint,6,opt,name=total_err_out,json=totalErrOut,proto3" json:"total_err_out,omitempty"`
-	// Total inbound packets dropped.
-	TotalDropIn uint64 `protobuf:"varint,7,opt,name=total_drop_in,json=totalDropIn,proto3" json:"total_drop_in,omitempty"`
-	// Total outbound packets dropped.
-	TotalDropOut uint64 `protobuf:"varint,8,opt,name=total_drop_out,json=totalDropOut,proto3" json:"total_drop_out,omitempty"`
-	// Total inbound FIFO buffer errors.
-	TotalFifoErrIn uint64 `protobuf:"varint,9,opt,name=total_fifo_err_in,json=totalFifoErrIn,proto3" json:"total_fifo_err_in,omitempty"`
-	// Total outbound FIFO buffer errors.
-	TotalFifoErrOut uint64 `protobuf:"varint,10,opt,name=total_fifo_err_out,json=totalFifoErrOut,proto3" json:"total_fifo_err_out,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *NodeMemoryMetrics) GetDirtyBytes() uint64 {
+	if x != nil {
+		return x.DirtyBytes
+	}
+	return 0
 }
 
-func (x *NetUsage) Reset() {
-	*x = NetUsage{}
-	mi := &file_proto_node_metrics_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NodeMemoryMetrics) GetWritebackBytes() uint64 {
+	if x != nil {
+		return x.WritebackBytes
+	}
+	return 0
 }
 
-func (x *NetUsage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeMemoryMetrics) GetCommittedAsBytes() uint64 {
+	if x != nil {
+		return x.CommittedAsBytes
+	}
+	return 0
 }
 
-func (*NetUsage) ProtoMessage() {}
-
-func (x *NetUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[3]
+func (x *NodeMemoryMetrics) GetCommitLimitBytes() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CommitLimitBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use NetUsage.ProtoReflect.Descriptor instead.
-func (*NetUsage) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{3}
+func (x *NodeMemoryMetrics) GetPageTablesBytes() uint64 {
+	if x != nil {
+		return x.PageTablesBytes
+	}
+	return 0
 }
 
-func (x *NetUsage) GetTotalBytesSent() uint64 {
+func (x *NodeMemoryMetrics) GetHugePagesTotal() uint64 {
 	if x != nil {
-		return x.TotalBytesSent
+		return x.HugePagesTotal
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalBytesReceived() uint64 {
+func (x *NodeMemoryMetrics) GetHugePagesFree() uint64 {
 	if x != nil {
-		return x.TotalBytesReceived
+		return x.HugePagesFree
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalPacketsSent() uint64 {
+func (x *NodeMemoryMetrics) GetHugePagesReserved() uint64 {
 	if x != nil {
-		return x.TotalPacketsSent
+		return x.HugePagesReserved
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalPacketsReceived() uint64 {
+func (x *NodeMemoryMetrics) GetHugePageSizeBytes() uint64 {
 	if x != nil {
-		return x.TotalPacketsReceived
+		return x.HugePageSizeBytes
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalErrIn() uint64 {
+func (x *NodeMemoryMetrics) GetSwapTotalBytes() uint64 {
 	if x != nil {
-		return x.TotalErrIn
+		return x.SwapTotalBytes
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalErrOut() uint64 {
+func (x *NodeMemoryMetrics) GetSwapFreeBytes() uint64 {
 	if x != nil {
-		return x.TotalErrOut
+		return x.SwapFreeBytes
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalDropIn() uint64 {
+func (x *NodeMemoryMetrics) GetSwapCachedBytes() uint64 {
 	if x != nil {
-		return x.TotalDropIn
+		return x.SwapCachedBytes
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalDropOut() uint64 {
+func (x *NodeMemoryMetrics) GetSwapInPages() uint64 {
 	if x != nil {
-		return x.TotalDropOut
+		return x.SwapInPages
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalFifoErrIn() uint64 {
+func (x *NodeMemoryMetrics) GetSwapInPagesPerSecond() float64 {
 	if x != nil {
-		return x.TotalFifoErrIn
+		return x.SwapInPagesPerSecond
 	}
 	return 0
 }
 
-func (x *NetUsage) GetTotalFifoErrOut() uint64 {
+func (x *NodeMemoryMetrics) GetSwapOutPages() uint64 {
 	if x != nil {
-		return x.TotalFifoErrOut
+		return x.SwapOutPages
 	}
 	return 0
 }
 
-// DiskUsage reports storage statistics for a specific mount point on the system.
-// It provides capacity, usage, and file system information.
-type DiskUsage struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Device string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
-	// Mount point of the file system (e.g., "/", "/data").
-	Mountpoint string `protobuf:"bytes,2,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
-	// File system type (e.g., "ext4", "xfs").
-	Fstype string `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
-	// Total capacity of the file system in bytes.
-	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	// Used space in bytes.
-	Used uint64 `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
-	// Free space available to the user in bytes.
-	Free uint64 `protobuf:"varint,6,opt,name=free,proto3" json:"free,omitempty"`
-	// Percentage of used space (used / total * 100).
-	UsedPercent   float64 `protobuf:"fixed64,7,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *NodeMemoryMetrics) GetSwapOutPagesPerSecond() float64 {
+	if x != nil {
+		return x.SwapOutPagesPerSecond
+	}
+	return 0
 }
 
-func (x *DiskUsage) Reset() {
-	*x = DiskUsage{}
-	mi := &file_proto_node_metrics_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *NodeMemoryMetrics) GetMajorPageFaults() uint64 {
+	if x != nil {
+		return x.MajorPageFaults
+	}
+	return 0
 }
 
-func (x *DiskUsage) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *NodeMemoryMetrics) GetMajorPageFaultsPerSecond() float64 {
+	if x != nil {
+		return x.MajorPageFaultsPerSecond
+	}
+	return 0
 }
 
-func (*DiskUsage) ProtoMessage() {}
-
-func (x *DiskUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[4]
+func (x *NodeMemoryMetrics) GetPagesScanned() uint64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PagesScanned
 	}
-	return mi.MessageOf(x)
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetPagesScannedPerSecond() float64 {
+	if x != nil {
+		return x.PagesScannedPerSecond
+	}
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetPagesScannedDirectPerSecond() float64 {
+	if x != nil {
+		return x.PagesScannedDirectPerSecond
+	}
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetPagesStolen() uint64 {
+	if x != nil {
+		return x.PagesStolen
+	}
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetPagesStolenPerSecond() float64 {
+	if x != nil {
+		return x.PagesStolenPerSecond
+	}
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetOomKills() uint64 {
+	if x != nil {
+		return x.OomKills
+	}
+	return 0
+}
+
+func (x *NodeMemoryMetrics) GetOomKillsPerSecond() float64 {
+	if x != nil {
+		return x.OomKillsPerSecond
+	}
+	return 0
+}
+
+// LoadMetrics reports scheduler saturation statistics from /proc/loadavg and /proc/stat.
+// Rates are computed over the interval elapsed since the previous collection cycle.
+type LoadMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Load average over the last 1 minute.
+	Load1 float64 `protobuf:"fixed64,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	// Load average over the last 5 minutes.
+	Load5 float64 `protobuf:"fixed64,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	// Load average over the last 15 minutes.
+	Load15 float64 `protobuf:"fixed64,3,opt,name=load15,proto3" json:"load15,omitempty"`
+	// Number of tasks currently runnable (running or waiting for a CPU).
+	ProcsRunning uint64 `protobuf:"varint,4,opt,name=procs_running,json=procsRunning,proto3" json:"procs_running,omitempty"`
+	// Number of tasks currently blocked waiting for I/O to complete.
+	ProcsBlocked uint64 `protobuf:"varint,5,opt,name=procs_blocked,json=procsBlocked,proto3" json:"procs_blocked,omitempty"`
+	// Total number of kernel scheduling entities (processes and threads).
+	ThreadsTotal uint64 `protobuf:"varint,6,opt,name=threads_total,json=threadsTotal,proto3" json:"threads_total,omitempty"`
+	// Cumulative number of context switches since boot.
+	ContextSwitches uint64 `protobuf:"varint,7,opt,name=context_switches,json=contextSwitches,proto3" json:"context_switches,omitempty"`
+	// Context switches per second.
+	ContextSwitchesPerSecond float64 `protobuf:"fixed64,8,opt,name=context_switches_per_second,json=contextSwitchesPerSecond,proto3" json:"context_switches_per_second,omitempty"`
+	// Cumulative number of forks (processes and threads created) since boot.
+	Forks uint64 `protobuf:"varint,9,opt,name=forks,proto3" json:"forks,omitempty"`
+	// Forks per second.
+	ForksPerSecond float64 `protobuf:"fixed64,10,opt,name=forks_per_second,json=forksPerSecond,proto3" json:"forks_per_second,omitempty"`
+	// Cumulative number of interrupts serviced since boot.
+	Interrupts uint64 `protobuf:"varint,11,opt,name=interrupts,proto3" json:"interrupts,omitempty"`
+	// Interrupts per second.
+	InterruptsPerSecond float64 `protobuf:"fixed64,12,opt,name=interrupts_per_second,json=interruptsPerSecond,proto3" json:"interrupts_per_second,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *LoadMetrics) Reset() {
+	*x = LoadMetrics{}
+	mi := &file_proto_node_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadMetrics) ProtoMessage() {}
+
+func (x *LoadMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadMetrics.ProtoReflect.Descriptor instead.
+func (*LoadMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LoadMetrics) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetProcsRunning() uint64 {
+	if x != nil {
+		return x.ProcsRunning
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetProcsBlocked() uint64 {
+	if x != nil {
+		return x.ProcsBlocked
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetThreadsTotal() uint64 {
+	if x != nil {
+		return x.ThreadsTotal
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetContextSwitches() uint64 {
+	if x != nil {
+		return x.ContextSwitches
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetContextSwitchesPerSecond() float64 {
+	if x != nil {
+		return x.ContextSwitchesPerSecond
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetForks() uint64 {
+	if x != nil {
+		return x.Forks
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetForksPerSecond() float64 {
+	if x != nil {
+		return x.ForksPerSecond
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetInterrupts() uint64 {
+	if x != nil {
+		return x.Interrupts
+	}
+	return 0
+}
+
+func (x *LoadMetrics) GetInterruptsPerSecond() float64 {
+	if x != nil {
+		return x.InterruptsPerSecond
+	}
+	return 0
+}
+
+// ProcessMemInfo represents basic memory usage statistics for a single process.
+// Used to report the most memory-intensive processes on the node.
+//
+// Kept for compatibility: top_memory_processes carries the same processes with more details.
+type ProcessMemInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Process ID (PID).
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Executable name of the process.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Resident memory usage in bytes (RSS).
+	Memory        uint64 `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessMemInfo) Reset() {
+	*x = ProcessMemInfo{}
+	mi := &file_proto_node_metrics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessMemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessMemInfo) ProtoMessage() {}
+
+func (x *ProcessMemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessMemInfo.ProtoReflect.Descriptor instead.
+func (*ProcessMemInfo) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProcessMemInfo) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessMemInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessMemInfo) GetMemory() uint64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+// NetworkStackMetrics reports the health of the TCP and UDP stacks of the host network namespace.
+// Cumulative counters are since boot; rates are computed over the interval elapsed since the previous
+// collection cycle.
+type NetworkStackMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cumulative TCP connections opened by the node (SYN sent).
+	TcpActiveOpens uint64 `protobuf:"varint,1,opt,name=tcp_active_opens,json=tcpActiveOpens,proto3" json:"tcp_active_opens,omitempty"`
+	// Active TCP opens per second.
+	TcpActiveOpensPerSecond float64 `protobuf:"fixed64,2,opt,name=tcp_active_opens_per_second,json=tcpActiveOpensPerSecond,proto3" json:"tcp_active_opens_per_second,omitempty"`
+	// Cumulative TCP connections accepted by the node (SYN received).
+	TcpPassiveOpens uint64 `protobuf:"varint,3,opt,name=tcp_passive_opens,json=tcpPassiveOpens,proto3" json:"tcp_passive_opens,omitempty"`
+	// Passive TCP opens per second.
+	TcpPassiveOpensPerSecond float64 `protobuf:"fixed64,4,opt,name=tcp_passive_opens_per_second,json=tcpPassiveOpensPerSecond,proto3" json:"tcp_passive_opens_per_second,omitempty"`
+	// Cumulative failed TCP connection attempts.
+	TcpAttemptFails uint64 `protobuf:"varint,5,opt,name=tcp_attempt_fails,json=tcpAttemptFails,proto3" json:"tcp_attempt_fails,omitempty"`
+	// Cumulative resets of established TCP connections.
+	TcpEstabResets uint64 `protobuf:"varint,6,opt,name=tcp_estab_resets,json=tcpEstabResets,proto3" json:"tcp_estab_resets,omitempty"`
+	// Number of TCP connections currently in the ESTABLISHED or CLOSE_WAIT state.
+	TcpCurrEstab uint64 `protobuf:"varint,7,opt,name=tcp_curr_estab,json=tcpCurrEstab,proto3" json:"tcp_curr_estab,omitempty"`
+	// Cumulative TCP segments received.
+	TcpInSegs uint64 `protobuf:"varint,8,opt,name=tcp_in_segs,json=tcpInSegs,proto3" json:"tcp_in_segs,omitempty"`
+	// Cumulative TCP segments sent, retransmissions excluded.
+	TcpOutSegs uint64 `protobuf:"varint,9,opt,name=tcp_out_segs,json=tcpOutSegs,proto3" json:"tcp_out_segs,omitempty"`
+	// Cumulative TCP segments retransmitted.
+	TcpRetransSegs uint64 `protobuf:"varint,10,opt,name=tcp_retrans_segs,json=tcpRetransSegs,proto3" json:"tcp_retrans_segs,omitempty"`
+	// TCP segments retransmitted per second.
+	TcpRetransSegsPerSecond float64 `protobuf:"fixed64,11,opt,name=tcp_retrans_segs_per_second,json=tcpRetransSegsPerSecond,proto3" json:"tcp_retrans_segs_per_second,omitempty"`
+	// Retransmitted segments in percent of the segments sent during the interval.
+	TcpRetransmitPercent float64 `protobuf:"fixed64,12,opt,name=tcp_retransmit_percent,json=tcpRetransmitPercent,proto3" json:"tcp_retransmit_percent,omitempty"`
+	// Cumulative TCP segments received in error (e.g., bad checksum).
+	TcpInErrs uint64 `protobuf:"varint,13,opt,name=tcp_in_errs,json=tcpInErrs,proto3" json:"tcp_in_errs,omitempty"`
+	// Cumulative TCP resets sent.
+	TcpOutRsts uint64 `protobuf:"varint,14,opt,name=tcp_out_rsts,json=tcpOutRsts,proto3" json:"tcp_out_rsts,omitempty"`
+	// TCP resets sent per second.
+	TcpOutRstsPerSecond float64 `protobuf:"fixed64,15,opt,name=tcp_out_rsts_per_second,json=tcpOutRstsPerSecond,proto3" json:"tcp_out_rsts_per_second,omitempty"`
+	// Cumulative connections dropped because a listen queue (accept backlog) was full.
+	TcpListenOverflows uint64 `protobuf:"varint,16,opt,name=tcp_listen_overflows,json=tcpListenOverflows,proto3" json:"tcp_listen_overflows,omitempty"`
+	// Listen queue overflows per second.
+	TcpListenOverflowsPerSecond float64 `protobuf:"fixed64,17,opt,name=tcp_listen_overflows_per_second,json=tcpListenOverflowsPerSecond,proto3" json:"tcp_listen_overflows_per_second,omitempty"`
+	// Cumulative SYNs dropped on listening sockets, overflows included.
+	TcpListenDrops uint64 `protobuf:"varint,18,opt,name=tcp_listen_drops,json=tcpListenDrops,proto3" json:"tcp_listen_drops,omitempty"`
+	// Listen drops per second.
+	TcpListenDropsPerSecond float64 `protobuf:"fixed64,19,opt,name=tcp_listen_drops_per_second,json=tcpListenDropsPerSecond,proto3" json:"tcp_listen_drops_per_second,omitempty"`
+	// Cumulative SYN cookies sent, a sign of SYN queue saturation.
+	TcpSyncookiesSent uint64 `protobuf:"varint,20,opt,name=tcp_syncookies_sent,json=tcpSyncookiesSent,proto3" json:"tcp_syncookies_sent,omitempty"`
+	// Cumulative valid SYN cookies received.
+	TcpSyncookiesRecv uint64 `protobuf:"varint,21,opt,name=tcp_syncookies_recv,json=tcpSyncookiesRecv,proto3" json:"tcp_syncookies_recv,omitempty"`
+	// Cumulative invalid SYN cookies received.
+	TcpSyncookiesFailed uint64 `protobuf:"varint,22,opt,name=tcp_syncookies_failed,json=tcpSyncookiesFailed,proto3" json:"tcp_syncookies_failed,omitempty"`
+	// Number of TCP sockets in use (IPv4 and IPv6).
+	TcpSocketsInUse uint64 `protobuf:"varint,23,opt,name=tcp_sockets_in_use,json=tcpSocketsInUse,proto3" json:"tcp_sockets_in_use,omitempty"`
+	// Number of TCP sockets in the TIME_WAIT state.
+	TcpTimeWait uint64 `protobuf:"varint,24,opt,name=tcp_time_wait,json=tcpTimeWait,proto3" json:"tcp_time_wait,omitempty"`
+	// Number of orphaned TCP sockets (closed by the application, not yet by the kernel).
+	TcpOrphans uint64 `protobuf:"varint,25,opt,name=tcp_orphans,json=tcpOrphans,proto3" json:"tcp_orphans,omitempty"`
+	// Number of allocated TCP sockets, including TIME_WAIT and orphans.
+	TcpAllocated uint64 `protobuf:"varint,26,opt,name=tcp_allocated,json=tcpAllocated,proto3" json:"tcp_allocated,omitempty"`
+	// Memory used by TCP socket buffers in bytes.
+	TcpMemoryBytes uint64 `protobuf:"varint,27,opt,name=tcp_memory_bytes,json=tcpMemoryBytes,proto3" json:"tcp_memory_bytes,omitempty"`
+	// Cumulative UDP datagrams delivered to applications.
+	UdpInDatagrams uint64 `protobuf:"varint,28,opt,name=udp_in_datagrams,json=udpInDatagrams,proto3" json:"udp_in_datagrams,omitempty"`
+	// Cumulative UDP datagrams sent.
+	UdpOutDatagrams uint64 `protobuf:"varint,29,opt,name=udp_out_datagrams,json=udpOutDatagrams,proto3" json:"udp_out_datagrams,omitempty"`
+	// Cumulative UDP datagrams received for a port without listener.
+	UdpNoPorts uint64 `protobuf:"varint,30,opt,name=udp_no_ports,json=udpNoPorts,proto3" json:"udp_no_ports,omitempty"`
+	// Cumulative UDP datagrams that could not be delivered, receive buffer errors included.
+	UdpInErrors uint64 `protobuf:"varint,31,opt,name=udp_in_errors,json=udpInErrors,proto3" json:"udp_in_errors,omitempty"`
+	// UDP receive errors per second.
+	UdpInErrorsPerSecond float64 `protobuf:"fixed64,32,opt,name=udp_in_errors_per_second,json=udpInErrorsPerSecond,proto3" json:"udp_in_errors_per_second,omitempty"`
+	// Cumulative UDP datagrams dropped because a socket receive buffer was full.
+	UdpRcvbufErrors uint64 `protobuf:"varint,33,opt,name=udp_rcvbuf_errors,json=udpRcvbufErrors,proto3" json:"udp_rcvbuf_errors,omitempty"`
+	// UDP receive buffer errors per second.
+	UdpRcvbufErrorsPerSecond float64 `protobuf:"fixed64,34,opt,name=udp_rcvbuf_errors_per_second,json=udpRcvbufErrorsPerSecond,proto3" json:"udp_rcvbuf_errors_per_second,omitempty"`
+	// Cumulative UDP datagrams dropped because a socket send buffer was full.
+	UdpSndbufErrors uint64 `protobuf:"varint,35,opt,name=udp_sndbuf_errors,json=udpSndbufErrors,proto3" json:"udp_sndbuf_errors,omitempty"`
+	// Number of UDP sockets in use (IPv4 and IPv6).
+	UdpSocketsInUse uint64 `protobuf:"varint,36,opt,name=udp_sockets_in_use,json=udpSocketsInUse,proto3" json:"udp_sockets_in_use,omitempty"`
+	// Memory used by UDP socket buffers in bytes.
+	UdpMemoryBytes uint64 `protobuf:"varint,37,opt,name=udp_memory_bytes,json=udpMemoryBytes,proto3" json:"udp_memory_bytes,omitempty"`
+	// Total number of sockets in use, all protocols.
+	SocketsUsed   uint64 `protobuf:"varint,38,opt,name=sockets_used,json=socketsUsed,proto3" json:"sockets_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkStackMetrics) Reset() {
+	*x = NetworkStackMetrics{}
+	mi := &file_proto_node_metrics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkStackMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStackMetrics) ProtoMessage() {}
+
+func (x *NetworkStackMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStackMetrics.ProtoReflect.Descriptor instead.
+func (*NetworkStackMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NetworkStackMetrics) GetTcpActiveOpens() uint64 {
+	if x != nil {
+		return x.TcpActiveOpens
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpActiveOpensPerSecond() float64 {
+	if x != nil {
+		return x.TcpActiveOpensPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpPassiveOpens() uint64 {
+	if x != nil {
+		return x.TcpPassiveOpens
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpPassiveOpensPerSecond() float64 {
+	if x != nil {
+		return x.TcpPassiveOpensPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpAttemptFails() uint64 {
+	if x != nil {
+		return x.TcpAttemptFails
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpEstabResets() uint64 {
+	if x != nil {
+		return x.TcpEstabResets
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpCurrEstab() uint64 {
+	if x != nil {
+		return x.TcpCurrEstab
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpInSegs() uint64 {
+	if x != nil {
+		return x.TcpInSegs
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpOutSegs() uint64 {
+	if x != nil {
+		return x.TcpOutSegs
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpRetransSegs() uint64 {
+	if x != nil {
+		return x.TcpRetransSegs
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpRetransSegsPerSecond() float64 {
+	if x != nil {
+		return x.TcpRetransSegsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpRetransmitPercent() float64 {
+	if x != nil {
+		return x.TcpRetransmitPercent
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpInErrs() uint64 {
+	if x != nil {
+		return x.TcpInErrs
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpOutRsts() uint64 {
+	if x != nil {
+		return x.TcpOutRsts
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpOutRstsPerSecond() float64 {
+	if x != nil {
+		return x.TcpOutRstsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpListenOverflows() uint64 {
+	if x != nil {
+		return x.TcpListenOverflows
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpListenOverflowsPerSecond() float64 {
+	if x != nil {
+		return x.TcpListenOverflowsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpListenDrops() uint64 {
+	if x != nil {
+		return x.TcpListenDrops
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpListenDropsPerSecond() float64 {
+	if x != nil {
+		return x.TcpListenDropsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpSyncookiesSent() uint64 {
+	if x != nil {
+		return x.TcpSyncookiesSent
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpSyncookiesRecv() uint64 {
+	if x != nil {
+		return x.TcpSyncookiesRecv
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpSyncookiesFailed() uint64 {
+	if x != nil {
+		return x.TcpSyncookiesFailed
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpSocketsInUse() uint64 {
+	if x != nil {
+		return x.TcpSocketsInUse
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpTimeWait() uint64 {
+	if x != nil {
+		return x.TcpTimeWait
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpOrphans() uint64 {
+	if x != nil {
+		return x.TcpOrphans
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpAllocated() uint64 {
+	if x != nil {
+		return x.TcpAllocated
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetTcpMemoryBytes() uint64 {
+	if x != nil {
+		return x.TcpMemoryBytes
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpInDatagrams() uint64 {
+	if x != nil {
+		return x.UdpInDatagrams
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpOutDatagrams() uint64 {
+	if x != nil {
+		return x.UdpOutDatagrams
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpNoPorts() uint64 {
+	if x != nil {
+		return x.UdpNoPorts
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpInErrors() uint64 {
+	if x != nil {
+		return x.UdpInErrors
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpInErrorsPerSecond() float64 {
+	if x != nil {
+		return x.UdpInErrorsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpRcvbufErrors() uint64 {
+	if x != nil {
+		return x.UdpRcvbufErrors
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpRcvbufErrorsPerSecond() float64 {
+	if x != nil {
+		return x.UdpRcvbufErrorsPerSecond
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpSndbufErrors() uint64 {
+	if x != nil {
+		return x.UdpSndbufErrors
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpSocketsInUse() uint64 {
+	if x != nil {
+		return x.UdpSocketsInUse
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetUdpMemoryBytes() uint64 {
+	if x != nil {
+		return x.UdpMemoryBytes
+	}
+	return 0
+}
+
+func (x *NetworkStackMetrics) GetSocketsUsed() uint64 {
+	if x != nil {
+		return x.SocketsUsed
+	}
+	return 0
+}
+
+// ConntrackMetrics reports the usage of the netfilter connection tracking table of the host network
+// namespace, from /proc/sys/net/netfilter and /proc/net/stat/nf_conntrack. Rates are computed over the
+// interval elapsed since the previous collection cycle.
+type ConntrackMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of entries currently in the conntrack table (nf_conntrack_count).
+	Entries uint64 `protobuf:"varint,1,opt,name=entries,proto3" json:"entries,omitempty"`
+	// Maximum size of the conntrack table (nf_conntrack_max).
+	MaxEntries uint64 `protobuf:"varint,2,opt,name=max_entries,json=maxEntries,proto3" json:"max_entries,omitempty"`
+	// Table usage in percent (entries / max_entries * 100). New connections are dropped at 100.
+	UtilizationPercent float64 `protobuf:"fixed64,3,opt,name=utilization_percent,json=utilizationPercent,proto3" json:"utilization_percent,omitempty"`
+	// Cumulative entries that could not be inserted (e.g., source port clashes), summed over CPUs.
+	InsertFailed uint64 `protobuf:"varint,4,opt,name=insert_failed,json=insertFailed,proto3" json:"insert_failed,omitempty"`
+	// Failed inserts per second.
+	InsertFailedPerSecond float64 `protobuf:"fixed64,5,opt,name=insert_failed_per_second,json=insertFailedPerSecond,proto3" json:"insert_failed_per_second,omitempty"`
+	// Cumulative packets dropped because a new entry could not be created (e.g., table full), summed over CPUs.
+	Drop uint64 `protobuf:"varint,6,opt,name=drop,proto3" json:"drop,omitempty"`
+	// Drops per second.
+	DropPerSecond float64 `protobuf:"fixed64,7,opt,name=drop_per_second,json=dropPerSecond,proto3" json:"drop_per_second,omitempty"`
+	// Cumulative entries evicted to make room for new ones when the table was full, summed over CPUs.
+	EarlyDrop uint64 `protobuf:"varint,8,opt,name=early_drop,json=earlyDrop,proto3" json:"early_drop,omitempty"`
+	// Early drops per second.
+	EarlyDropPerSecond float64 `protobuf:"fixed64,9,opt,name=early_drop_per_second,json=earlyDropPerSecond,proto3" json:"early_drop_per_second,omitempty"`
+	// Cumulative table lookups restarted because of a hash resize or concurrent update, summed over CPUs.
+	SearchRestart uint64 `protobuf:"varint,10,opt,name=search_restart,json=searchRestart,proto3" json:"search_restart,omitempty"`
+	// Search restarts per second.
+	SearchRestartPerSecond float64 `protobuf:"fixed64,11,opt,name=search_restart_per_second,json=searchRestartPerSecond,proto3" json:"search_restart_per_second,omitempty"`
+	// Cumulative packets that could not be tracked (invalid state), summed over CPUs.
+	Invalid uint64 `protobuf:"varint,12,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	// Invalid packets per second.
+	InvalidPerSecond float64 `protobuf:"fixed64,13,opt,name=invalid_per_second,json=invalidPerSecond,proto3" json:"invalid_per_second,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConntrackMetrics) Reset() {
+	*x = ConntrackMetrics{}
+	mi := &file_proto_node_metrics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConntrackMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConntrackMetrics) ProtoMessage() {}
+
+func (x *ConntrackMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConntrackMetrics.ProtoReflect.Descriptor instead.
+func (*ConntrackMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConntrackMetrics) GetEntries() uint64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetMaxEntries() uint64 {
+	if x != nil {
+		return x.MaxEntries
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetUtilizationPercent() float64 {
+	if x != nil {
+		return x.UtilizationPercent
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetInsertFailed() uint64 {
+	if x != nil {
+		return x.InsertFailed
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetInsertFailedPerSecond() float64 {
+	if x != nil {
+		return x.InsertFailedPerSecond
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetDrop() uint64 {
+	if x != nil {
+		return x.Drop
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetDropPerSecond() float64 {
+	if x != nil {
+		return x.DropPerSecond
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetEarlyDrop() uint64 {
+	if x != nil {
+		return x.EarlyDrop
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetEarlyDropPerSecond() float64 {
+	if x != nil {
+		return x.EarlyDropPerSecond
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetSearchRestart() uint64 {
+	if x != nil {
+		return x.SearchRestart
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetSearchRestartPerSecond() float64 {
+	if x != nil {
+		return x.SearchRestartPerSecond
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetInvalid() uint64 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ConntrackMetrics) GetInvalidPerSecond() float64 {
+	if x != nil {
+		return x.InvalidPerSecond
+	}
+	return 0
+}
+
+// KernelLimits reports the usage of kernel resources that are exhausted silently on busy nodes:
+// file handles, PIDs and threads, inotify instances and watches, and the PID limit of the pods.
+type KernelLimits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of allocated file handles (first value of /proc/sys/fs/file-nr).
+	FileHandlesAllocated uint64 `protobuf:"varint,1,opt,name=file_handles_allocated,json=fileHandlesAllocated,proto3" json:"file_handles_allocated,omitempty"`
+	// System-wide maximum number of file handles (fs.file-max).
+	FileHandlesMax uint64 `protobuf:"varint,2,opt,name=file_handles_max,json=fileHandlesMax,proto3" json:"file_handles_max,omitempty"`
+	// Allocated file handles in percent of file_handles_max.
+	FileHandlesUsedPercent float64 `protobuf:"fixed64,3,opt,name=file_handles_used_percent,json=fileHandlesUsedPercent,proto3" json:"file_handles_used_percent,omitempty"`
+	// Number of processes on the node.
+	Processes uint64 `protobuf:"varint,4,opt,name=processes,proto3" json:"processes,omitempty"`
+	// Number of threads (kernel scheduling entities) on the node, each one consuming a PID.
+	Threads uint64 `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	// Highest PID value plus one (kernel.pid_max), i.e. the size of the PID space.
+	PidMax uint64 `protobuf:"varint,6,opt,name=pid_max,json=pidMax,proto3" json:"pid_max,omitempty"`
+	// Threads in percent of pid_max.
+	PidUsedPercent float64 `protobuf:"fixed64,7,opt,name=pid_used_percent,json=pidUsedPercent,proto3" json:"pid_used_percent,omitempty"`
+	// System-wide maximum number of threads (kernel.threads-max).
+	ThreadsMax uint64 `protobuf:"varint,8,opt,name=threads_max,json=threadsMax,proto3" json:"threads_max,omitempty"`
+	// Threads in percent of threads_max.
+	ThreadsUsedPercent float64 `protobuf:"fixed64,9,opt,name=threads_used_percent,json=threadsUsedPercent,proto3" json:"threads_used_percent,omitempty"`
+	// Whether the inotify usage below could be computed (requires access to the file descriptors of every process).
+	InotifyScanned bool `protobuf:"varint,10,opt,name=inotify_scanned,json=inotifyScanned,proto3" json:"inotify_scanned,omitempty"`
+	// Number of inotify instances on the node.
+	InotifyInstances uint64 `protobuf:"varint,11,opt,name=inotify_instances,json=inotifyInstances,proto3" json:"inotify_instances,omitempty"`
+	// Number of inotify watches on the node.
+	InotifyWatches uint64 `protobuf:"varint,12,opt,name=inotify_watches,json=inotifyWatches,proto3" json:"inotify_watches,omitempty"`
+	// Maximum number of inotify instances per user (fs.inotify.max_user_instances).
+	InotifyMaxUserInstances uint64 `protobuf:"varint,13,opt,name=inotify_max_user_instances,json=inotifyMaxUserInstances,proto3" json:"inotify_max_user_instances,omitempty"`
+	// Maximum number of inotify watches per user (fs.inotify.max_user_watches).
+	InotifyMaxUserWatches uint64 `protobuf:"varint,14,opt,name=inotify_max_user_watches,json=inotifyMaxUserWatches,proto3" json:"inotify_max_user_watches,omitempty"`
+	// Number of inotify instances of the user owning the most, to compare with inotify_max_user_instances.
+	InotifyTopUserInstances uint64 `protobuf:"varint,15,opt,name=inotify_top_user_instances,json=inotifyTopUserInstances,proto3" json:"inotify_top_user_instances,omitempty"`
+	// Number of inotify watches of the user owning the most, to compare with inotify_max_user_watches.
+	InotifyTopUserWatches uint64 `protobuf:"varint,16,opt,name=inotify_top_user_watches,json=inotifyTopUserWatches,proto3" json:"inotify_top_user_watches,omitempty"`
+	// Number of PIDs used by all pods (pids.current of the kubepods cgroup), 0 if unavailable.
+	KubepodsPidsCurrent uint64 `protobuf:"varint,17,opt,name=kubepods_pids_current,json=kubepodsPidsCurrent,proto3" json:"kubepods_pids_current,omitempty"`
+	// PID limit of all pods (pids.max of the kubepods cgroup), 0 if unlimited or unavailable.
+	KubepodsPidsMax uint64 `protobuf:"varint,18,opt,name=kubepods_pids_max,json=kubepodsPidsMax,proto3" json:"kubepods_pids_max,omitempty"`
+	// kubepods_pids_current in percent of kubepods_pids_max, 0 if unlimited.
+	KubepodsPidsUsedPercent float64 `protobuf:"fixed64,19,opt,name=kubepods_pids_used_percent,json=kubepodsPidsUsedPercent,proto3" json:"kubepods_pids_used_percent,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *KernelLimits) Reset() {
+	*x = KernelLimits{}
+	mi := &file_proto_node_metrics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KernelLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KernelLimits) ProtoMessage() {}
+
+func (x *KernelLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KernelLimits.ProtoReflect.Descriptor instead.
+func (*KernelLimits) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KernelLimits) GetFileHandlesAllocated() uint64 {
+	if x != nil {
+		return x.FileHandlesAllocated
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetFileHandlesMax() uint64 {
+	if x != nil {
+		return x.FileHandlesMax
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetFileHandlesUsedPercent() float64 {
+	if x != nil {
+		return x.FileHandlesUsedPercent
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetProcesses() uint64 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetThreads() uint64 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetPidMax() uint64 {
+	if x != nil {
+		return x.PidMax
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetPidUsedPercent() float64 {
+	if x != nil {
+		return x.PidUsedPercent
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetThreadsMax() uint64 {
+	if x != nil {
+		return x.ThreadsMax
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetThreadsUsedPercent() float64 {
+	if x != nil {
+		return x.ThreadsUsedPercent
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyScanned() bool {
+	if x != nil {
+		return x.InotifyScanned
+	}
+	return false
+}
+
+func (x *KernelLimits) GetInotifyInstances() uint64 {
+	if x != nil {
+		return x.InotifyInstances
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyWatches() uint64 {
+	if x != nil {
+		return x.InotifyWatches
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyMaxUserInstances() uint64 {
+	if x != nil {
+		return x.InotifyMaxUserInstances
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyMaxUserWatches() uint64 {
+	if x != nil {
+		return x.InotifyMaxUserWatches
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyTopUserInstances() uint64 {
+	if x != nil {
+		return x.InotifyTopUserInstances
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetInotifyTopUserWatches() uint64 {
+	if x != nil {
+		return x.InotifyTopUserWatches
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetKubepodsPidsCurrent() uint64 {
+	if x != nil {
+		return x.KubepodsPidsCurrent
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetKubepodsPidsMax() uint64 {
+	if x != nil {
+		return x.KubepodsPidsMax
+	}
+	return 0
+}
+
+func (x *KernelLimits) GetKubepodsPidsUsedPercent() float64 {
+	if x != nil {
+		return x.KubepodsPidsUsedPercent
+	}
+	return 0
+}
+
+// ProcessStats describes a single process of the node, with its resource usage and the pod and
+// container owning it. Rates are computed over the interval elapsed since the previous collection cycle.
+type ProcessStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Process ID (PID), as seen from the host.
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Parent process ID.
+	Ppid int32 `protobuf:"varint,2,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	// Executable name of the process (comm).
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Command line with arguments separated by spaces, truncated to a bounded length.
+	Cmdline string `protobuf:"bytes,4,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
+	// Real user ID of the process.
+	Uid uint32 `protobuf:"varint,5,opt,name=uid,proto3" json:"uid,omitempty"`
+	// User name of the process, empty if the user ID cannot be resolved.
+	User string `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	// Process state (e.g., "R" running, "S" sleeping, "D" uninterruptible sleep, "Z" zombie).
+	State string `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	// Process start time in milliseconds since epoch.
+	CreateTime int64 `protobuf:"varint,8,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Resident memory usage in bytes (RSS).
+	RssBytes uint64 `protobuf:"varint,9,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	// Virtual memory size in bytes.
+	VmsBytes uint64 `protobuf:"varint,10,opt,name=vms_bytes,json=vmsBytes,proto3" json:"vms_bytes,omitempty"`
+	// Cumulative CPU time (user + system) in seconds.
+	CpuTimeSeconds float64 `protobuf:"fixed64,11,opt,name=cpu_time_seconds,json=cpuTimeSeconds,proto3" json:"cpu_time_seconds,omitempty"`
+	// CPU usage in percent of one CPU (may exceed 100 for multi-threaded processes).
+	CpuPercent float64 `protobuf:"fixed64,12,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	// Number of threads.
+	Threads uint32 `protobuf:"varint,13,opt,name=threads,proto3" json:"threads,omitempty"`
+	// Number of open file descriptors, 0 if unreadable.
+	Fds uint32 `protobuf:"varint,14,opt,name=fds,proto3" json:"fds,omitempty"`
+	// Cumulative bytes read from storage, 0 if unreadable.
+	ReadBytes uint64 `protobuf:"varint,15,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// Cumulative bytes written to storage, 0 if unreadable.
+	WriteBytes uint64 `protobuf:"varint,16,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// Bytes read from storage per second, 0 until the process was reported in two consecutive cycles.
+	ReadBytesPerSecond float64 `protobuf:"fixed64,17,opt,name=read_bytes_per_second,json=readBytesPerSecond,proto3" json:"read_bytes_per_second,omitempty"`
+	// Bytes written to storage per second, 0 until the process was reported in two consecutive cycles.
+	WriteBytesPerSecond float64 `protobuf:"fixed64,18,opt,name=write_bytes_per_second,json=writeBytesPerSecond,proto3" json:"write_bytes_per_second,omitempty"`
+	// Cgroup path of the process (e.g., "/kubepods/burstable/pod<uid>/<container id>").
+	Cgroup string `protobuf:"bytes,19,opt,name=cgroup,proto3" json:"cgroup,omitempty"`
+	// UID of the owning pod (matches PodMetrics.uid), empty for processes outside pods.
+	PodUid string `protobuf:"bytes,20,opt,name=pod_uid,json=podUid,proto3" json:"pod_uid,omitempty"`
+	// ID of the owning container (matches ContainerMetrics.id), empty for processes outside containers.
+	ContainerId   string `protobuf:"bytes,21,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessStats) Reset() {
+	*x = ProcessStats{}
+	mi := &file_proto_node_metrics_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStats) ProtoMessage() {}
+
+func (x *ProcessStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStats.ProtoReflect.Descriptor instead.
+func (*ProcessStats) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ProcessStats) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetPpid() int32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetVmsBytes() uint64 {
+	if x != nil {
+		return x.VmsBytes
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetCpuTimeSeconds() float64 {
+	if x != nil {
+		return x.CpuTimeSeconds
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetThreads() uint32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetFds() uint32 {
+	if x != nil {
+		return x.Fds
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetWriteBytes() uint64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetReadBytesPerSecond() float64 {
+	if x != nil {
+		return x.ReadBytesPerSecond
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetWriteBytesPerSecond() float64 {
+	if x != nil {
+		return x.WriteBytesPerSecond
+	}
+	return 0
+}
+
+func (x *ProcessStats) GetCgroup() string {
+	if x != nil {
+		return x.Cgroup
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetPodUid() string {
+	if x != nil {
+		return x.PodUid
+	}
+	return ""
+}
+
+func (x *ProcessStats) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+// CpuInfo provides metadata and real-time usage for a single logical CPU (hardware thread).
+// Logical CPUs are grouped into cores and physical sockets (CPUs).
+type CpuInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Model name of the processor (e.g., "Intel(R) Core(TM) i9-10900K CPU @ 3.70GHz").
+	Model string `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Number of physical cores reported for the CPU. May be duplicated across logical threads.
+	Cores int32 `protobuf:"varint,2,opt,name=cores,proto3" json:"cores,omitempty"`
+	// Clock speed in MHz.
+	Mhz int32 `protobuf:"varint,3,opt,name=mhz,proto3" json:"mhz,omitempty"`
+	// Vendor identifier (e.g., "GenuineIntel", "AuthenticAMD").
+	VendorId string `protobuf:"bytes,4,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	// Identifier of the physical CPU socket.
+	PhysicalId string `protobuf:"bytes,5,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	// Identifier of the core within the physical socket.
+	CoreId string `protobuf:"bytes,6,opt,name=core_id,json=coreId,proto3" json:"core_id,omitempty"`
+	// Logical CPU/thread ID as reported by the OS.
+	Cpu int32 `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// Percentage of time this logical CPU was actively executing instructions over the sampling interval
+	// (the time elapsed since the previous collection cycle).
+	Usage float64 `protobuf:"fixed64,8,opt,name=usage,proto3" json:"usage,omitempty"`
+	// Breakdown of this logical CPU time by mode over the sampling interval.
+	Times         *CpuTimes `protobuf:"bytes,9,opt,name=times,proto3" json:"times,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CpuInfo) Reset() {
+	*x = CpuInfo{}
+	mi := &file_proto_node_metrics_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CpuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuInfo) ProtoMessage() {}
+
+func (x *CpuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuInfo.ProtoReflect.Descriptor instead.
+func (*CpuInfo) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CpuInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CpuInfo) GetCores() int32 {
+	if x != nil {
+		return x.Cores
+	}
+	return 0
+}
+
+func (x *CpuInfo) GetMhz() int32 {
+	if x != nil {
+		return x.Mhz
+	}
+	return 0
+}
+
+func (x *CpuInfo) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *CpuInfo) GetPhysicalId() string {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return ""
+}
+
+func (x *CpuInfo) GetCoreId() string {
+	if x != nil {
+		return x.CoreId
+	}
+	return ""
+}
+
+func (x *CpuInfo) GetCpu() int32 {
+	if x != nil {
+		return x.Cpu
+	}
+	return 0
+}
+
+func (x *CpuInfo) GetUsage() float64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *CpuInfo) GetTimes() *CpuTimes {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+// CpuTimes breaks down CPU time by mode, as a percentage (0.0–100.0) of the sampling interval.
+// Values are computed from the /proc/stat counters of two consecutive collection cycles.
+// The modes add up to 100; guest time is reported separately and excluded from user and nice.
+type CpuTimes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time spent in user mode (excluding guest time).
+	User float64 `protobuf:"fixed64,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Time spent in user mode with low priority (excluding guest_nice time).
+	Nice float64 `protobuf:"fixed64,2,opt,name=nice,proto3" json:"nice,omitempty"`
+	// Time spent in kernel mode.
+	System float64 `protobuf:"fixed64,3,opt,name=system,proto3" json:"system,omitempty"`
+	// Time spent idle.
+	Idle float64 `protobuf:"fixed64,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	// Time spent idle while waiting for I/O to complete.
+	Iowait float64 `protobuf:"fixed64,5,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	// Time spent servicing hardware interrupts.
+	Irq float64 `protobuf:"fixed64,6,opt,name=irq,proto3" json:"irq,omitempty"`
+	// Time spent servicing software interrupts.
+	Softirq float64 `protobuf:"fixed64,7,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	// Time stolen by the hypervisor to run other virtual machines.
+	Steal float64 `protobuf:"fixed64,8,opt,name=steal,proto3" json:"steal,omitempty"`
+	// Time spent running a virtual CPU for guest operating systems.
+	Guest float64 `protobuf:"fixed64,9,opt,name=guest,proto3" json:"guest,omitempty"`
+	// Time spent running a low-priority virtual CPU for guest operating systems.
+	GuestNice     float64 `protobuf:"fixed64,10,opt,name=guest_nice,json=guestNice,proto3" json:"guest_nice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CpuTimes) Reset() {
+	*x = CpuTimes{}
+	mi := &file_proto_node_metrics_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CpuTimes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuTimes) ProtoMessage() {}
+
+func (x *CpuTimes) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuTimes.ProtoReflect.Descriptor instead.
+func (*CpuTimes) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CpuTimes) GetUser() float64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetNice() float64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetSystem() float64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetSoftirq() float64 {
+	if x != nil {
+		return x.Softirq
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetGuest() float64 {
+	if x != nil {
+		return x.Guest
+	}
+	return 0
+}
+
+func (x *CpuTimes) GetGuestNice() float64 {
+	if x != nil {
+		return x.GuestNice
+	}
+	return 0
+}
+
+// NetUsage aggregates cumulative network statistics across all the node interfaces.
+// Useful for identifying total traffic, errors, and dropped packets on the node.
+//
+// Loopback and pod-side virtual interfaces are included, so pod traffic is counted both on
+// its virtual interface and on the physical NIC; see InterfaceStat for a per-interface view.
+type NetUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total number of bytes sent.
+	TotalBytesSent uint64 `protobuf:"varint,1,opt,name=total_bytes_sent,json=totalBytesSent,proto3" json:"total_bytes_sent,omitempty"`
+	// Total number of bytes received.
+	TotalBytesReceived uint64 `protobuf:"varint,2,opt,name=total_bytes_received,json=totalBytesReceived,proto3" json:"total_bytes_received,omitempty"`
+	// Total number of packets sent.
+	TotalPacketsSent uint64 `protobuf:"varint,3,opt,name=total_packets_sent,json=totalPacketsSent,proto3" json:"total_packets_sent,omitempty"`
+	// Total number of packets received.
+	TotalPacketsReceived uint64 `protobuf:"varint,4,opt,name=total_packets_received,json=totalPacketsReceived,proto3" json:"total_packets_received,omitempty"`
+	// Total inbound packet errors.
+	TotalErrIn uint64 `protobuf:"varint,5,opt,name=total_err_in,json=totalErrIn,proto3" json:"total_err_in,omitempty"`
+	// Total outbound packet errors.
+	TotalErrOut uint64 `protobuf:"varint,6,opt,name=total_err_out,json=totalErrOut,proto3" json:"total_err_out,omitempty"`
+	// Total inbound packets dropped.
+	TotalDropIn uint64 `protobuf:"varint,7,opt,name=total_drop_in,json=totalDropIn,proto3" json:"total_drop_in,omitempty"`
+	// Total outbound packets dropped.
+	TotalDropOut uint64 `protobuf:"varint,8,opt,name=total_drop_out,json=totalDropOut,proto3" json:"total_drop_out,omitempty"`
+	// Total inbound FIFO buffer errors.
+	TotalFifoErrIn uint64 `protobuf:"varint,9,opt,name=total_fifo_err_in,json=totalFifoErrIn,proto3" json:"total_fifo_err_in,omitempty"`
+	// Total outbound FIFO buffer errors.
+	TotalFifoErrOut uint64 `protobuf:"varint,10,opt,name=total_fifo_err_out,json=totalFifoErrOut,proto3" json:"total_fifo_err_out,omitempty"`
+	// Bytes sent per second since the previous collection cycle.
+	BytesSentPerSecond float64 `protobuf:"fixed64,11,opt,name=bytes_sent_per_second,json=bytesSentPerSecond,proto3" json:"bytes_sent_per_second,omitempty"`
+	// Bytes received per second since the previous collection cycle.
+	BytesReceivedPerSecond float64 `protobuf:"fixed64,12,opt,name=bytes_received_per_second,json=bytesReceivedPerSecond,proto3" json:"bytes_received_per_second,omitempty"`
+	// Packets sent per second since the previous collection cycle.
+	PacketsSentPerSecond float64 `protobuf:"fixed64,13,opt,name=packets_sent_per_second,json=packetsSentPerSecond,proto3" json:"packets_sent_per_second,omitempty"`
+	// Packets received per second since the previous collection cycle.
+	PacketsReceivedPerSecond float64 `protobuf:"fixed64,14,opt,name=packets_received_per_second,json=packetsReceivedPerSecond,proto3" json:"packets_received_per_second,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *NetUsage) Reset() {
+	*x = NetUsage{}
+	mi := &file_proto_node_metrics_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetUsage) ProtoMessage() {}
+
+func (x *NetUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetUsage.ProtoReflect.Descriptor instead.
+func (*NetUsage) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NetUsage) GetTotalBytesSent() uint64 {
+	if x != nil {
+		return x.TotalBytesSent
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalBytesReceived() uint64 {
+	if x != nil {
+		return x.TotalBytesReceived
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalPacketsSent() uint64 {
+	if x != nil {
+		return x.TotalPacketsSent
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalPacketsReceived() uint64 {
+	if x != nil {
+		return x.TotalPacketsReceived
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalErrIn() uint64 {
+	if x != nil {
+		return x.TotalErrIn
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalErrOut() uint64 {
+	if x != nil {
+		return x.TotalErrOut
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalDropIn() uint64 {
+	if x != nil {
+		return x.TotalDropIn
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalDropOut() uint64 {
+	if x != nil {
+		return x.TotalDropOut
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalFifoErrIn() uint64 {
+	if x != nil {
+		return x.TotalFifoErrIn
+	}
+	return 0
+}
+
+func (x *NetUsage) GetTotalFifoErrOut() uint64 {
+	if x != nil {
+		return x.TotalFifoErrOut
+	}
+	return 0
+}
+
+func (x *NetUsage) GetBytesSentPerSecond() float64 {
+	if x != nil {
+		return x.BytesSentPerSecond
+	}
+	return 0
+}
+
+func (x *NetUsage) GetBytesReceivedPerSecond() float64 {
+	if x != nil {
+		return x.BytesReceivedPerSecond
+	}
+	return 0
+}
+
+func (x *NetUsage) GetPacketsSentPerSecond() float64 {
+	if x != nil {
+		return x.PacketsSentPerSecond
+	}
+	return 0
+}
+
+func (x *NetUsage) GetPacketsReceivedPerSecond() float64 {
+	if x != nil {
+		return x.PacketsReceivedPerSecond
+	}
+	return 0
+}
+
+// DiskUsage reports storage statistics for a specific mount point on the system.
+// It provides capacity, inode usage, and file system information.
+//
+// Filesystems are deduplicated by device: bind mounts of an already reported filesystem
+// are listed in other_mountpoints instead of being reported again.
+type DiskUsage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Device string                 `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	// Mount point of the file system (e.g., "/", "/data").
+	Mountpoint string `protobuf:"bytes,2,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	// File system type (e.g., "ext4", "xfs").
+	Fstype string `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	// Total capacity of the file system in bytes.
+	Total uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	// Used space in bytes.
+	Used uint64 `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
+	// Free space available to the user in bytes.
+	Free uint64 `protobuf:"varint,6,opt,name=free,proto3" json:"free,omitempty"`
+	// Percentage of used space (used / total * 100).
+	UsedPercent float64 `protobuf:"fixed64,7,opt,name=used_percent,json=usedPercent,proto3" json:"used_percent,omitempty"`
+	// Total number of inodes, 0 for filesystems without a fixed inode table (e.g., btrfs).
+	InodesTotal uint64 `protobuf:"varint,8,opt,name=inodes_total,json=inodesTotal,proto3" json:"inodes_total,omitempty"`
+	// Number of inodes in use.
+	InodesUsed uint64 `protobuf:"varint,9,opt,name=inodes_used,json=inodesUsed,proto3" json:"inodes_used,omitempty"`
+	// Number of free inodes.
+	InodesFree uint64 `protobuf:"varint,10,opt,name=inodes_free,json=inodesFree,proto3" json:"inodes_free,omitempty"`
+	// Percentage of inodes in use (inodes_used / inodes_total * 100).
+	InodesUsedPercent float64 `protobuf:"fixed64,11,opt,name=inodes_used_percent,json=inodesUsedPercent,proto3" json:"inodes_used_percent,omitempty"`
+	// Kernel name of the backing block device (e.g., "sda1", "dm-0"), empty for virtual filesystems.
+	BlockDevice string `protobuf:"bytes,12,opt,name=block_device,json=blockDevice,proto3" json:"block_device,omitempty"`
+	// Other selected mountpoints of the same filesystem (bind mounts), reported once under mountpoint.
+	OtherMountpoints []string `protobuf:"bytes,13,rep,name=other_mountpoints,json=otherMountpoints,proto3" json:"other_mountpoints,omitempty"`
+	// Whether the filesystem holds the kubelet root directory (e.g., /var/lib/kubelet).
+	KubeletRoot bool `protobuf:"varint,14,opt,name=kubelet_root,json=kubeletRoot,proto3" json:"kubelet_root,omitempty"`
+	// Whether the filesystem holds a container runtime root directory (e.g., /var/lib/containerd).
+	RuntimeRoot   bool `protobuf:"varint,15,opt,name=runtime_root,json=runtimeRoot,proto3" json:"runtime_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	mi := &file_proto_node_metrics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 // Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
 func (*DiskUsage) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{4}
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiskUsage) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesTotal() uint64 {
+	if x != nil {
+		return x.InodesTotal
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesUsed() uint64 {
+	if x != nil {
+		return x.InodesUsed
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesFree() uint64 {
+	if x != nil {
+		return x.InodesFree
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetInodesUsedPercent() float64 {
+	if x != nil {
+		return x.InodesUsedPercent
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetBlockDevice() string {
+	if x != nil {
+		return x.BlockDevice
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetOtherMountpoints() []string {
+	if x != nil {
+		return x.OtherMountpoints
+	}
+	return nil
+}
+
+func (x *DiskUsage) GetKubeletRoot() bool {
+	if x != nil {
+		return x.KubeletRoot
+	}
+	return false
+}
+
+func (x *DiskUsage) GetRuntimeRoot() bool {
+	if x != nil {
+		return x.RuntimeRoot
+	}
+	return false
+}
+
+// DiskIOSummary aggregates disk I/O statistics across the physical disks of the node.
+// It provides cumulative read/write operations and bytes transferred since boot.
+//
+// Only whole disks that are not stacked on other block devices are summed: partitions,
+// device-mapper and md devices, loop, ram and zram devices are left out, so that every
+// byte is counted once. Per-device details are reported in DiskIOStats.
+type DiskIOSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total bytes read from all disks.
+	TotalReadBytes uint64 `protobuf:"varint,1,opt,name=total_read_bytes,json=totalReadBytes,proto3" json:"total_read_bytes,omitempty"`
+	// Total bytes written to all disks.
+	TotalWriteBytes uint64 `protobuf:"varint,2,opt,name=total_write_bytes,json=totalWriteBytes,proto3" json:"total_write_bytes,omitempty"`
+	// Total read operations across all disks.
+	TotalReadOps uint64 `protobuf:"varint,3,opt,name=total_read_ops,json=totalReadOps,proto3" json:"total_read_ops,omitempty"`
+	// Total write operations across all disks.
+	TotalWriteOps uint64 `protobuf:"varint,4,opt,name=total_write_ops,json=totalWriteOps,proto3" json:"total_write_ops,omitempty"`
+	// Bytes read per second since the previous collection cycle.
+	ReadBytesPerSecond float64 `protobuf:"fixed64,5,opt,name=read_bytes_per_second,json=readBytesPerSecond,proto3" json:"read_bytes_per_second,omitempty"`
+	// Bytes written per second since the previous collection cycle.
+	WriteBytesPerSecond float64 `protobuf:"fixed64,6,opt,name=write_bytes_per_second,json=writeBytesPerSecond,proto3" json:"write_bytes_per_second,omitempty"`
+	// Read operations per second since the previous collection cycle.
+	ReadOpsPerSecond float64 `protobuf:"fixed64,7,opt,name=read_ops_per_second,json=readOpsPerSecond,proto3" json:"read_ops_per_second,omitempty"`
+	// Write operations per second since the previous collection cycle.
+	WriteOpsPerSecond float64 `protobuf:"fixed64,8,opt,name=write_ops_per_second,json=writeOpsPerSecond,proto3" json:"write_ops_per_second,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DiskIOSummary) Reset() {
+	*x = DiskIOSummary{}
+	mi := &file_proto_node_metrics_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskIOSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIOSummary) ProtoMessage() {}
+
+func (x *DiskIOSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIOSummary.ProtoReflect.Descriptor instead.
+func (*DiskIOSummary) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DiskUsage) GetDevice() string {
+func (x *DiskIOSummary) GetTotalReadBytes() uint64 {
 	if x != nil {
-		return x.Device
+		return x.TotalReadBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *DiskUsage) GetMountpoint() string {
+func (x *DiskIOSummary) GetTotalWriteBytes() uint64 {
 	if x != nil {
-		return x.Mountpoint
+		return x.TotalWriteBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *DiskUsage) GetFstype() string {
+func (x *DiskIOSummary) GetTotalReadOps() uint64 {
 	if x != nil {
-		return x.Fstype
+		return x.TotalReadOps
 	}
-	return ""
+	return 0
 }
 
-func (x *DiskUsage) GetTotal() uint64 {
+func (x *DiskIOSummary) GetTotalWriteOps() uint64 {
 	if x != nil {
-		return x.Total
+		return x.TotalWriteOps
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetUsed() uint64 {
+func (x *DiskIOSummary) GetReadBytesPerSecond() float64 {
 	if x != nil {
-		return x.Used
+		return x.ReadBytesPerSecond
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetFree() uint64 {
+func (x *DiskIOSummary) GetWriteBytesPerSecond() float64 {
 	if x != nil {
-		return x.Free
+		return x.WriteBytesPerSecond
 	}
 	return 0
 }
 
-func (x *DiskUsage) GetUsedPercent() float64 {
+func (x *DiskIOSummary) GetReadOpsPerSecond() float64 {
 	if x != nil {
-		return x.UsedPercent
+		return x.ReadOpsPerSecond
 	}
 	return 0
 }
 
-// DiskIOSummary aggregates disk I/O statistics across all block devices on the node.
-// It provides cumulative read/write operations and bytes transferred since boot.
-type DiskIOSummary struct {
+func (x *DiskIOSummary) GetWriteOpsPerSecond() float64 {
+	if x != nil {
+		return x.WriteOpsPerSecond
+	}
+	return 0
+}
+
+// DiskIOStats reports the I/O statistics of a single block device from /proc/diskstats.
+// Rates, utilization and latencies are computed over the interval elapsed since the previous collection cycle.
+type DiskIOStats struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Total bytes read from all disks.
-	TotalReadBytes uint64 `protobuf:"varint,1,opt,name=total_read_bytes,json=totalReadBytes,proto3" json:"total_read_bytes,omitempty"`
-	// Total bytes written to all disks.
-	TotalWriteBytes uint64 `protobuf:"varint,2,opt,name=total_write_bytes,json=totalWriteBytes,proto3" json:"total_write_bytes,omitempty"`
-	// Total read operations across all disks.
-	TotalReadOps uint64 `protobuf:"varint,3,opt,name=total_read_ops,json=totalReadOps,proto3" json:"total_read_ops,omitempty"`
-	// Total write operations across all disks.
-	TotalWriteOps uint64 `protobuf:"varint,4,opt,name=total_write_ops,json=totalWriteOps,proto3" json:"total_write_ops,omitempty"`
+	// Kernel name of the block device (e.g., "sda", "nvme0n1p1", "dm-0").
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the device is a partition of another block device.
+	IsPartition bool `protobuf:"varint,2,opt,name=is_partition,json=isPartition,proto3" json:"is_partition,omitempty"`
+	// Name of the whole disk holding the partition (e.g., "sda" for "sda1"), empty for whole disks.
+	Parent string `protobuf:"bytes,3,opt,name=parent,proto3" json:"parent,omitempty"`
+	// Mountpoints of the DiskUsage entries backed by this device.
+	Mountpoints []string `protobuf:"bytes,4,rep,name=mountpoints,proto3" json:"mountpoints,omitempty"`
+	// Whether the device is summed in DiskIOSummary.
+	CountedInSummary bool `protobuf:"varint,5,opt,name=counted_in_summary,json=countedInSummary,proto3" json:"counted_in_summary,omitempty"`
+	// Cumulative bytes read.
+	ReadBytes uint64 `protobuf:"varint,6,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`
+	// Cumulative bytes written.
+	WriteBytes uint64 `protobuf:"varint,7,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`
+	// Cumulative completed read operations.
+	ReadOps uint64 `protobuf:"varint,8,opt,name=read_ops,json=readOps,proto3" json:"read_ops,omitempty"`
+	// Cumulative completed write operations.
+	WriteOps uint64 `protobuf:"varint,9,opt,name=write_ops,json=writeOps,proto3" json:"write_ops,omitempty"`
+	// Cumulative adjacent read requests merged by the I/O scheduler.
+	MergedReadOps uint64 `protobuf:"varint,10,opt,name=merged_read_ops,json=mergedReadOps,proto3" json:"merged_read_ops,omitempty"`
+	// Cumulative adjacent write requests merged by the I/O scheduler.
+	MergedWriteOps uint64 `protobuf:"varint,11,opt,name=merged_write_ops,json=mergedWriteOps,proto3" json:"merged_write_ops,omitempty"`
+	// Cumulative time spent reading, in milliseconds.
+	ReadTimeMs uint64 `protobuf:"varint,12,opt,name=read_time_ms,json=readTimeMs,proto3" json:"read_time_ms,omitempty"`
+	// Cumulative time spent writing, in milliseconds.
+	WriteTimeMs uint64 `protobuf:"varint,13,opt,name=write_time_ms,json=writeTimeMs,proto3" json:"write_time_ms,omitempty"`
+	// Cumulative time during which the device had I/O in flight, in milliseconds.
+	IoTimeMs uint64 `protobuf:"varint,14,opt,name=io_time_ms,json=ioTimeMs,proto3" json:"io_time_ms,omitempty"`
+	// Cumulative I/O time weighted by the number of requests in flight, in milliseconds.
+	WeightedIoTimeMs uint64 `protobuf:"varint,15,opt,name=weighted_io_time_ms,json=weightedIoTimeMs,proto3" json:"weighted_io_time_ms,omitempty"`
+	// Number of I/O requests currently in flight.
+	InFlight uint64 `protobuf:"varint,16,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// Bytes read per second.
+	ReadBytesPerSecond float64 `protobuf:"fixed64,17,opt,name=read_bytes_per_second,json=readBytesPerSecond,proto3" json:"read_bytes_per_second,omitempty"`
+	// Bytes written per second.
+	WriteBytesPerSecond float64 `protobuf:"fixed64,18,opt,name=write_bytes_per_second,json=writeBytesPerSecond,proto3" json:"write_bytes_per_second,omitempty"`
+	// Read operations per second.
+	ReadOpsPerSecond float64 `protobuf:"fixed64,19,opt,name=read_ops_per_second,json=readOpsPerSecond,proto3" json:"read_ops_per_second,omitempty"`
+	// Write operations per second.
+	WriteOpsPerSecond float64 `protobuf:"fixed64,20,opt,name=write_ops_per_second,json=writeOpsPerSecond,proto3" json:"write_ops_per_second,omitempty"`
+	// Percentage of time during which the device had I/O in flight (0 to 100).
+	UtilizationPercent float64 `protobuf:"fixed64,21,opt,name=utilization_percent,json=utilizationPercent,proto3" json:"utilization_percent,omitempty"`
+	// Average time in milliseconds for I/O requests completed during the interval, including queueing.
+	AwaitMs float64 `protobuf:"fixed64,22,opt,name=await_ms,json=awaitMs,proto3" json:"await_ms,omitempty"`
+	// Average time in milliseconds for read requests completed during the interval.
+	ReadAwaitMs float64 `protobuf:"fixed64,23,opt,name=read_await_ms,json=readAwaitMs,proto3" json:"read_await_ms,omitempty"`
+	// Average time in milliseconds for write requests completed during the interval.
+	WriteAwaitMs  float64 `protobuf:"fixed64,24,opt,name=write_await_ms,json=writeAwaitMs,proto3" json:"write_await_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskIOSummary) Reset() {
-	*x = DiskIOSummary{}
-	mi := &file_proto_node_metrics_proto_msgTypes[5]
+func (x *DiskIOStats) Reset() {
+	*x = DiskIOStats{}
+	mi := &file_proto_node_metrics_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskIOSummary) String() string {
+func (x *DiskIOStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskIOSummary) ProtoMessage() {}
+func (*DiskIOStats) ProtoMessage() {}
 
-func (x *DiskIOSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[5]
+func (x *DiskIOStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_node_metrics_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,35 +2726,175 @@ func (x *DiskIOSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskIOSummary.ProtoReflect.Descriptor instead.
-func (*DiskIOSummary) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DiskIOStats.ProtoReflect.Descriptor instead.
+func (*DiskIOStats) Descriptor() ([]byte, []int) {
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DiskIOSummary) GetTotalReadBytes() uint64 {
+func (x *DiskIOStats) GetName() string {
 	if x != nil {
-		return x.TotalReadBytes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DiskIOStats) GetIsPartition() bool {
+	if x != nil {
+		return x.IsPartition
+	}
+	return false
+}
+
+func (x *DiskIOStats) GetParent() string {
+	if x != nil {
+		return x.Parent
+	}
+	return ""
+}
+
+func (x *DiskIOStats) GetMountpoints() []string {
+	if x != nil {
+		return x.Mountpoints
+	}
+	return nil
+}
+
+func (x *DiskIOStats) GetCountedInSummary() bool {
+	if x != nil {
+		return x.CountedInSummary
+	}
+	return false
+}
+
+func (x *DiskIOStats) GetReadBytes() uint64 {
+	if x != nil {
+		return x.ReadBytes
 	}
 	return 0
 }
 
-func (x *DiskIOSummary) GetTotalWriteBytes() uint64 {
+func (x *DiskIOStats) GetWriteBytes() uint64 {
 	if x != nil {
-		return x.TotalWriteBytes
+		return x.WriteBytes
 	}
 	return 0
 }
 
-func (x *DiskIOSummary) GetTotalReadOps() uint64 {
+func (x *DiskIOStats) GetReadOps() uint64 {
 	if x != nil {
-		return x.TotalReadOps
+		return x.ReadOps
 	}
 	return 0
 }
 
-func (x *DiskIOSummary) GetTotalWriteOps() uint64 {
+func (x *DiskIOStats) GetWriteOps() uint64 {
 	if x != nil {
-		return x.TotalWriteOps
+		return x.WriteOps
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetMergedReadOps() uint64 {
+	if x != nil {
+		return x.MergedReadOps
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetMergedWriteOps() uint64 {
+	if x != nil {
+		return x.MergedWriteOps
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetReadTimeMs() uint64 {
+	if x != nil {
+		return x.ReadTimeMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetWriteTimeMs() uint64 {
+	if x != nil {
+		return x.WriteTimeMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetIoTimeMs() uint64 {
+	if x != nil {
+		return x.IoTimeMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetWeightedIoTimeMs() uint64 {
+	if x != nil {
+		return x.WeightedIoTimeMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetInFlight() uint64 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetReadBytesPerSecond() float64 {
+	if x != nil {
+		return x.ReadBytesPerSecond
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetWriteBytesPerSecond() float64 {
+	if x != nil {
+		return x.WriteBytesPerSecond
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetReadOpsPerSecond() float64 {
+	if x != nil {
+		return x.ReadOpsPerSecond
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetWriteOpsPerSecond() float64 {
+	if x != nil {
+		return x.WriteOpsPerSecond
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetUtilizationPercent() float64 {
+	if x != nil {
+		return x.UtilizationPercent
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetAwaitMs() float64 {
+	if x != nil {
+		return x.AwaitMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetReadAwaitMs() float64 {
+	if x != nil {
+		return x.ReadAwaitMs
+	}
+	return 0
+}
+
+func (x *DiskIOStats) GetWriteAwaitMs() float64 {
+	if x != nil {
+		return x.WriteAwaitMs
 	}
 	return 0
 }
@@ -797,14 +2913,53 @@ type InterfaceStat struct {
 	// List of operational flags set on the interface (e.g., "up", "broadcast", "loopback").
 	Flags []string `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`
 	// IP addresses assigned to the interface, including both IPv4 and IPv6.
-	Addrs         []string `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Addrs []string `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	// Link speed in Mbit/s as reported by /sys/class/net/<name>/speed, 0 if unknown (e.g., virtual interfaces).
+	SpeedMbps int64 `protobuf:"varint,7,opt,name=speed_mbps,json=speedMbps,proto3" json:"speed_mbps,omitempty"`
+	// Duplex mode of the link ("full", "half" or "unknown"), empty if not reported.
+	Duplex string `protobuf:"bytes,8,opt,name=duplex,proto3" json:"duplex,omitempty"`
+	// RFC 2863 operational state of the interface (e.g., "up", "down", "lowerlayerdown", "unknown").
+	Operstate string `protobuf:"bytes,9,opt,name=operstate,proto3" json:"operstate,omitempty"`
+	// Number of times the link carrier went up or down since the interface was created.
+	CarrierChanges uint64 `protobuf:"varint,10,opt,name=carrier_changes,json=carrierChanges,proto3" json:"carrier_changes,omitempty"`
+	// Whether the interface matches the agent exclusion prefixes (by default loopback and pod-side
+	// veth/cali interfaces): its counters and rates below are then not reported.
+	CountersExcluded bool `protobuf:"varint,11,opt,name=counters_excluded,json=countersExcluded,proto3" json:"counters_excluded,omitempty"`
+	// Cumulative bytes sent.
+	BytesSent uint64 `protobuf:"varint,12,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// Cumulative bytes received.
+	BytesReceived uint64 `protobuf:"varint,13,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	// Cumulative packets sent.
+	PacketsSent uint64 `protobuf:"varint,14,opt,name=packets_sent,json=packetsSent,proto3" json:"packets_sent,omitempty"`
+	// Cumulative packets received.
+	PacketsReceived uint64 `protobuf:"varint,15,opt,name=packets_received,json=packetsReceived,proto3" json:"packets_received,omitempty"`
+	// Cumulative inbound packet errors.
+	ErrIn uint64 `protobuf:"varint,16,opt,name=err_in,json=errIn,proto3" json:"err_in,omitempty"`
+	// Cumulative outbound packet errors.
+	ErrOut uint64 `protobuf:"varint,17,opt,name=err_out,json=errOut,proto3" json:"err_out,omitempty"`
+	// Cumulative inbound packets dropped.
+	DropIn uint64 `protobuf:"varint,18,opt,name=drop_in,json=dropIn,proto3" json:"drop_in,omitempty"`
+	// Cumulative outbound packets dropped.
+	DropOut uint64 `protobuf:"varint,19,opt,name=drop_out,json=dropOut,proto3" json:"drop_out,omitempty"`
+	// Bytes sent per second since the previous collection cycle.
+	BytesSentPerSecond float64 `protobuf:"fixed64,20,opt,name=bytes_sent_per_second,json=bytesSentPerSecond,proto3" json:"bytes_sent_per_second,omitempty"`
+	// Bytes received per second since the previous collection cycle.
+	BytesReceivedPerSecond float64 `protobuf:"fixed64,21,opt,name=bytes_received_per_second,json=bytesReceivedPerSecond,proto3" json:"bytes_received_per_second,omitempty"`
+	// Packets sent per second since the previous collection cycle.
+	PacketsSentPerSecond float64 `protobuf:"fixed64,22,opt,name=packets_sent_per_second,json=packetsSentPerSecond,proto3" json:"packets_sent_per_second,omitempty"`
+	// Packets received per second since the previous collection cycle.
+	PacketsReceivedPerSecond float64 `protobuf:"fixed64,23,opt,name=packets_received_per_second,json=packetsReceivedPerSecond,proto3" json:"packets_received_per_second,omitempty"`
+	// Inbound and outbound packet errors per second since the previous collection cycle.
+	ErrorsPerSecond float64 `protobuf:"fixed64,24,opt,name=errors_per_second,json=errorsPerSecond,proto3" json:"errors_per_second,omitempty"`
+	// Inbound and outbound packets dropped per second since the previous collection cycle.
+	DropsPerSecond float64 `protobuf:"fixed64,25,opt,name=drops_per_second,json=dropsPerSecond,proto3" json:"drops_per_second,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InterfaceStat) Reset() {
 	*x = InterfaceStat{}
-	mi := &file_proto_node_metrics_proto_msgTypes[6]
+	mi := &file_proto_node_metrics_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -816,7 +2971,7 @@ func (x *InterfaceStat) String() string {
 func (*InterfaceStat) ProtoMessage() {}
 
 func (x *InterfaceStat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[6]
+	mi := &file_proto_node_metrics_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -829,7 +2984,7 @@ func (x *InterfaceStat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterfaceStat.ProtoReflect.Descriptor instead.
 func (*InterfaceStat) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{6}
+	return file_proto_node_metrics_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *InterfaceStat) GetIndex() int32 {
@@ -874,143 +3029,144 @@ func (x *InterfaceStat) GetAddrs() []string {
 	return nil
 }
 
-// PsiData captures pressure stall information (PSI) for a specific resource type
-// such as CPU, memory, or I/O. It quantifies how often and how severely the system
-// experienced delays due to contention for that resource.
-type PsiData struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Cumulative time (in microseconds) that tasks have been stalled due to resource pressure since system boot.
-	Total *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
-	// Rolling average of stall time over the last 10 seconds, expressed as a percentage (0.0–100.0).
-	Avg10 *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=avg10,proto3" json:"avg10,omitempty"`
-	// Rolling average of stall time over the last 60 seconds, expressed as a percentage (0.0–100.0).
-	Avg60 *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=avg60,proto3" json:"avg60,omitempty"`
-	// Rolling average of stall time over the last 300 seconds, expressed as a percentage (0.0–100.0).
-	Avg300        *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=avg300,proto3" json:"avg300,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterfaceStat) GetSpeedMbps() int64 {
+	if x != nil {
+		return x.SpeedMbps
+	}
+	return 0
 }
 
-func (x *PsiData) Reset() {
-	*x = PsiData{}
-	mi := &file_proto_node_metrics_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterfaceStat) GetDuplex() string {
+	if x != nil {
+		return x.Duplex
+	}
+	return ""
 }
 
-func (x *PsiData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterfaceStat) GetOperstate() string {
+	if x != nil {
+		return x.Operstate
+	}
+	return ""
 }
 
-func (*PsiData) ProtoMessage() {}
+func (x *InterfaceStat) GetCarrierChanges() uint64 {
+	if x != nil {
+		return x.CarrierChanges
+	}
+	return 0
+}
 
-func (x *PsiData) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[7]
+func (x *InterfaceStat) GetCountersExcluded() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CountersExcluded
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use PsiData.ProtoReflect.Descriptor instead.
-func (*PsiData) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{7}
+func (x *InterfaceStat) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
 }
 
-func (x *PsiData) GetTotal() *wrapperspb.UInt64Value {
+func (x *InterfaceStat) GetBytesReceived() uint64 {
 	if x != nil {
-		return x.Total
+		return x.BytesReceived
 	}
-	return nil
+	return 0
 }
 
-func (x *PsiData) GetAvg10() *wrapperspb.DoubleValue {
+func (x *InterfaceStat) GetPacketsSent() uint64 {
 	if x != nil {
-		return x.Avg10
+		return x.PacketsSent
 	}
-	return nil
+	return 0
 }
 
-func (x *PsiData) GetAvg60() *wrapperspb.DoubleValue {
+func (x *InterfaceStat) GetPacketsReceived() uint64 {
 	if x != nil {
-		return x.Avg60
+		return x.PacketsReceived
 	}
-	return nil
+	return 0
 }
 
-func (x *PsiData) GetAvg300() *wrapperspb.DoubleValue {
+func (x *InterfaceStat) GetErrIn() uint64 {
 	if x != nil {
-		return x.Avg300
+		return x.ErrIn
 	}
-	return nil
+	return 0
 }
 
-// PsiMetrics reports pressure stall metrics for a resource in two distinct conditions:
-// - 'some': partial contention (some tasks stalled)
-// - 'full': total contention (all tasks stalled)
-type PsiMetrics struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Represents partial pressure — periods when at least one task was stalled, but others may have progressed.
-	Some *PsiData `protobuf:"bytes,1,opt,name=some,proto3" json:"some,omitempty"`
-	// Represents full pressure — periods when all non-idle tasks were stalled and no useful work could be done.
-	Full          *PsiData `protobuf:"bytes,2,opt,name=full,proto3" json:"full,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *InterfaceStat) GetErrOut() uint64 {
+	if x != nil {
+		return x.ErrOut
+	}
+	return 0
 }
 
-func (x *PsiMetrics) Reset() {
-	*x = PsiMetrics{}
-	mi := &file_proto_node_metrics_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *InterfaceStat) GetDropIn() uint64 {
+	if x != nil {
+		return x.DropIn
+	}
+	return 0
 }
 
-func (x *PsiMetrics) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *InterfaceStat) GetDropOut() uint64 {
+	if x != nil {
+		return x.DropOut
+	}
+	return 0
+}
+
+func (x *InterfaceStat) GetBytesSentPerSecond() float64 {
+	if x != nil {
+		return x.BytesSentPerSecond
+	}
+	return 0
 }
 
-func (*PsiMetrics) ProtoMessage() {}
+func (x *InterfaceStat) GetBytesReceivedPerSecond() float64 {
+	if x != nil {
+		return x.BytesReceivedPerSecond
+	}
+	return 0
+}
 
-func (x *PsiMetrics) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_node_metrics_proto_msgTypes[8]
+func (x *InterfaceStat) GetPacketsSentPerSecond() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PacketsSentPerSecond
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use PsiMetrics.ProtoReflect.Descriptor instead.
-func (*PsiMetrics) Descriptor() ([]byte, []int) {
-	return file_proto_node_metrics_proto_rawDescGZIP(), []int{8}
+func (x *InterfaceStat) GetPacketsReceivedPerSecond() float64 {
+	if x != nil {
+		return x.PacketsReceivedPerSecond
+	}
+	return 0
 }
 
-func (x *PsiMetrics) GetSome() *PsiData {
+func (x *InterfaceStat) GetErrorsPerSecond() float64 {
 	if x != nil {
-		return x.Some
+		return x.ErrorsPerSecond
 	}
-	return nil
+	return 0
 }
 
-func (x *PsiMetrics) GetFull() *PsiData {
+func (x *InterfaceStat) GetDropsPerSecond() float64 {
 	if x != nil {
-		return x.Full
+		return x.DropsPerSecond
 	}
-	return nil
+	return 0
 }
 
 var File_proto_node_metrics_proto protoreflect.FileDescriptor
 
 const file_proto_node_metrics_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/node_metrics.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\"\xbf\t\n" +
+	"\x18proto/node_metrics.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x0fproto/psi.proto\"\x97\x10\n" +
 	"\vNodeMetrics\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12?\n" +
 	"\fprimary_ipv4\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vprimaryIpv4\x12?\n" +
@@ -1042,11 +3198,191 @@ const file_proto_node_metrics_proto_rawDesc = "" +
 	"\x0fpsi_cpu_metrics\x18\x1a \x01(\v2\x13.metrics.PsiMetricsR\rpsiCpuMetrics\x12A\n" +
 	"\x12psi_memory_metrics\x18\x1b \x01(\v2\x13.metrics.PsiMetricsR\x10psiMemoryMetrics\x129\n" +
 	"\x0epsi_io_metrics\x18\x1c \x01(\v2\x13.metrics.PsiMetricsR\fpsiIoMetrics\x12E\n" +
-	"\x12network_interfaces\x18\x1d \x03(\v2\x16.metrics.InterfaceStatR\x11networkInterfaces\"N\n" +
+	"\x12network_interfaces\x18\x1d \x03(\v2\x16.metrics.InterfaceStatR\x11networkInterfaces\x129\n" +
+	"\x0ftotal_cpu_times\x18\x1e \x01(\v2\x11.metrics.CpuTimesR\rtotalCpuTimes\x127\n" +
+	"\fload_metrics\x18\x1f \x01(\v2\x14.metrics.LoadMetricsR\vloadMetrics\x12A\n" +
+	"\x0ememory_metrics\x18  \x01(\v2\x1a.metrics.NodeMemoryMetricsR\rmemoryMetrics\x128\n" +
+	"\rdisk_io_stats\x18! \x03(\v2\x14.metrics.DiskIOStatsR\vdiskIoStats\x12A\n" +
+	"\x11top_cpu_processes\x18\" \x03(\v2\x15.metrics.ProcessStatsR\x0ftopCpuProcesses\x12G\n" +
+	"\x14top_memory_processes\x18# \x03(\v2\x15.metrics.ProcessStatsR\x12topMemoryProcesses\x12P\n" +
+	"\x15network_stack_metrics\x18$ \x01(\v2\x1c.metrics.NetworkStackMetricsR\x13networkStackMetrics\x12F\n" +
+	"\x11conntrack_metrics\x18% \x01(\v2\x19.metrics.ConntrackMetricsR\x10conntrackMetrics\x12:\n" +
+	"\rkernel_limits\x18& \x01(\v2\x15.metrics.KernelLimitsR\fkernelLimits\x12;\n" +
+	"\x0fpsi_irq_metrics\x18' \x01(\v2\x13.metrics.PsiMetricsR\rpsiIrqMetrics\x12#\n" +
+	"\rpsi_supported\x18( \x01(\bR\fpsiSupported\x12H\n" +
+	"\fqos_pressure\x18) \x03(\v2%.metrics.NodeMetrics.QosPressureEntryR\vqosPressure\x1aW\n" +
+	"\x10QosPressureEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\x05value\x18\x02 \x01(\v2\x17.metrics.CgroupPressureR\x05value:\x028\x01\"\xd4\v\n" +
+	"\x11NodeMemoryMetrics\x12\x1d\n" +
+	"\n" +
+	"free_bytes\x18\x01 \x01(\x04R\tfreeBytes\x12#\n" +
+	"\rbuffers_bytes\x18\x02 \x01(\x04R\fbuffersBytes\x12!\n" +
+	"\fcached_bytes\x18\x03 \x01(\x04R\vcachedBytes\x12\x1f\n" +
+	"\vshmem_bytes\x18\x04 \x01(\x04R\n" +
+	"shmemBytes\x124\n" +
+	"\x16slab_reclaimable_bytes\x18\x05 \x01(\x04R\x14slabReclaimableBytes\x128\n" +
+	"\x18slab_unreclaimable_bytes\x18\x06 \x01(\x04R\x16slabUnreclaimableBytes\x12\x1d\n" +
+	"\n" +
+	"anon_bytes\x18\a \x01(\x04R\tanonBytes\x121\n" +
+	"\x15anon_huge_pages_bytes\x18\b \x01(\x04R\x12anonHugePagesBytes\x12\x1f\n" +
+	"\vdirty_bytes\x18\t \x01(\x04R\n" +
+	"dirtyBytes\x12'\n" +
+	"\x0fwriteback_bytes\x18\n" +
+	" \x01(\x04R\x0ewritebackBytes\x12,\n" +
+	"\x12committed_as_bytes\x18\v \x01(\x04R\x10committedAsBytes\x12,\n" +
+	"\x12commit_limit_bytes\x18\f \x01(\x04R\x10commitLimitBytes\x12*\n" +
+	"\x11page_tables_bytes\x18\r \x01(\x04R\x0fpageTablesBytes\x12(\n" +
+	"\x10huge_pages_total\x18\x0e \x01(\x04R\x0ehugePagesTotal\x12&\n" +
+	"\x0fhuge_pages_free\x18\x0f \x01(\x04R\rhugePagesFree\x12.\n" +
+	"\x13huge_pages_reserved\x18\x10 \x01(\x04R\x11hugePagesReserved\x12/\n" +
+	"\x14huge_page_size_bytes\x18\x11 \x01(\x04R\x11hugePageSizeBytes\x12(\n" +
+	"\x10swap_total_bytes\x18\x12 \x01(\x04R\x0eswapTotalBytes\x12&\n" +
+	"\x0fswap_free_bytes\x18\x13 \x01(\x04R\rswapFreeBytes\x12*\n" +
+	"\x11swap_cached_bytes\x18\x14 \x01(\x04R\x0fswapCachedBytes\x12\"\n" +
+	"\rswap_in_pages\x18\x15 \x01(\x04R\vswapInPages\x126\n" +
+	"\x18swap_in_pages_per_second\x18\x16 \x01(\x01R\x14swapInPagesPerSecond\x12$\n" +
+	"\x0eswap_out_pages\x18\x17 \x01(\x04R\fswapOutPages\x128\n" +
+	"\x19swap_out_pages_per_second\x18\x18 \x01(\x01R\x15swapOutPagesPerSecond\x12*\n" +
+	"\x11major_page_faults\x18\x19 \x01(\x04R\x0fmajorPageFaults\x12>\n" +
+	"\x1cmajor_page_faults_per_second\x18\x1a \x01(\x01R\x18majorPageFaultsPerSecond\x12#\n" +
+	"\rpages_scanned\x18\x1b \x01(\x04R\fpagesScanned\x127\n" +
+	"\x18pages_scanned_per_second\x18\x1c \x01(\x01R\x15pagesScannedPerSecond\x12D\n" +
+	"\x1fpages_scanned_direct_per_second\x18\x1d \x01(\x01R\x1bpagesScannedDirectPerSecond\x12!\n" +
+	"\fpages_stolen\x18\x1e \x01(\x04R\vpagesStolen\x125\n" +
+	"\x17pages_stolen_per_second\x18\x1f \x01(\x01R\x14pagesStolenPerSecond\x12\x1b\n" +
+	"\toom_kills\x18  \x01(\x04R\boomKills\x12/\n" +
+	"\x14oom_kills_per_second\x18! \x01(\x01R\x11oomKillsPerSecond\"\xbe\x03\n" +
+	"\vLoadMetrics\x12\x14\n" +
+	"\x05load1\x18\x01 \x01(\x01R\x05load1\x12\x14\n" +
+	"\x05load5\x18\x02 \x01(\x01R\x05load5\x12\x16\n" +
+	"\x06load15\x18\x03 \x01(\x01R\x06load15\x12#\n" +
+	"\rprocs_running\x18\x04 \x01(\x04R\fprocsRunning\x12#\n" +
+	"\rprocs_blocked\x18\x05 \x01(\x04R\fprocsBlocked\x12#\n" +
+	"\rthreads_total\x18\x06 \x01(\x04R\fthreadsTotal\x12)\n" +
+	"\x10context_switches\x18\a \x01(\x04R\x0fcontextSwitches\x12=\n" +
+	"\x1bcontext_switches_per_second\x18\b \x01(\x01R\x18contextSwitchesPerSecond\x12\x14\n" +
+	"\x05forks\x18\t \x01(\x04R\x05forks\x12(\n" +
+	"\x10forks_per_second\x18\n" +
+	" \x01(\x01R\x0eforksPerSecond\x12\x1e\n" +
+	"\n" +
+	"interrupts\x18\v \x01(\x04R\n" +
+	"interrupts\x122\n" +
+	"\x15interrupts_per_second\x18\f \x01(\x01R\x13interruptsPerSecond\"N\n" +
 	"\x0eProcessMemInfo\x12\x10\n" +
 	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06memory\x18\x03 \x01(\x04R\x06memory\"\xc6\x01\n" +
+	"\x06memory\x18\x03 \x01(\x04R\x06memory\"\xd8\r\n" +
+	"\x13NetworkStackMetrics\x12(\n" +
+	"\x10tcp_active_opens\x18\x01 \x01(\x04R\x0etcpActiveOpens\x12<\n" +
+	"\x1btcp_active_opens_per_second\x18\x02 \x01(\x01R\x17tcpActiveOpensPerSecond\x12*\n" +
+	"\x11tcp_passive_opens\x18\x03 \x01(\x04R\x0ftcpPassiveOpens\x12>\n" +
+	"\x1ctcp_passive_opens_per_second\x18\x04 \x01(\x01R\x18tcpPassiveOpensPerSecond\x12*\n" +
+	"\x11tcp_attempt_fails\x18\x05 \x01(\x04R\x0ftcpAttemptFails\x12(\n" +
+	"\x10tcp_estab_resets\x18\x06 \x01(\x04R\x0etcpEstabResets\x12$\n" +
+	"\x0etcp_curr_estab\x18\a \x01(\x04R\ftcpCurrEstab\x12\x1e\n" +
+	"\vtcp_in_segs\x18\b \x01(\x04R\ttcpInSegs\x12 \n" +
+	"\ftcp_out_segs\x18\t \x01(\x04R\n" +
+	"tcpOutSegs\x12(\n" +
+	"\x10tcp_retrans_segs\x18\n" +
+	" \x01(\x04R\x0etcpRetransSegs\x12<\n" +
+	"\x1btcp_retrans_segs_per_second\x18\v \x01(\x01R\x17tcpRetransSegsPerSecond\x124\n" +
+	"\x16tcp_retransmit_percent\x18\f \x01(\x01R\x14tcpRetransmitPercent\x12\x1e\n" +
+	"\vtcp_in_errs\x18\r \x01(\x04R\ttcpInErrs\x12 \n" +
+	"\ftcp_out_rsts\x18\x0e \x01(\x04R\n" +
+	"tcpOutRsts\x124\n" +
+	"\x17tcp_out_rsts_per_second\x18\x0f \x01(\x01R\x13tcpOutRstsPerSecond\x120\n" +
+	"\x14tcp_listen_overflows\x18\x10 \x01(\x04R\x12tcpListenOverflows\x12D\n" +
+	"\x1ftcp_listen_overflows_per_second\x18\x11 \x01(\x01R\x1btcpListenOverflowsPerSecond\x12(\n" +
+	"\x10tcp_listen_drops\x18\x12 \x01(\x04R\x0etcpListenDrops\x12<\n" +
+	"\x1btcp_listen_drops_per_second\x18\x13 \x01(\x01R\x17tcpListenDropsPerSecond\x12.\n" +
+	"\x13tcp_syncookies_sent\x18\x14 \x01(\x04R\x11tcpSyncookiesSent\x12.\n" +
+	"\x13tcp_syncookies_recv\x18\x15 \x01(\x04R\x11tcpSyncookiesRecv\x122\n" +
+	"\x15tcp_syncookies_failed\x18\x16 \x01(\x04R\x13tcpSyncookiesFailed\x12+\n" +
+	"\x12tcp_sockets_in_use\x18\x17 \x01(\x04R\x0ftcpSocketsInUse\x12\"\n" +
+	"\rtcp_time_wait\x18\x18 \x01(\x04R\vtcpTimeWait\x12\x1f\n" +
+	"\vtcp_orphans\x18\x19 \x01(\x04R\n" +
+	"tcpOrphans\x12#\n" +
+	"\rtcp_allocated\x18\x1a \x01(\x04R\ftcpAllocated\x12(\n" +
+	"\x10tcp_memory_bytes\x18\x1b \x01(\x04R\x0etcpMemoryBytes\x12(\n" +
+	"\x10udp_in_datagrams\x18\x1c \x01(\x04R\x0eudpInDatagrams\x12*\n" +
+	"\x11udp_out_datagrams\x18\x1d \x01(\x04R\x0fudpOutDatagrams\x12 \n" +
+	"\fudp_no_ports\x18\x1e \x01(\x04R\n" +
+	"udpNoPorts\x12\"\n" +
+	"\rudp_in_errors\x18\x1f \x01(\x04R\vudpInErrors\x126\n" +
+	"\x18udp_in_errors_per_second\x18  \x01(\x01R\x14udpInErrorsPerSecond\x12*\n" +
+	"\x11udp_rcvbuf_errors\x18! \x01(\x04R\x0fudpRcvbufErrors\x12>\n" +
+	"\x1cudp_rcvbuf_errors_per_second\x18\" \x01(\x01R\x18udpRcvbufErrorsPerSecond\x12*\n" +
+	"\x11udp_sndbuf_errors\x18# \x01(\x04R\x0fudpSndbufErrors\x12+\n" +
+	"\x12udp_sockets_in_use\x18$ \x01(\x04R\x0fudpSocketsInUse\x12(\n" +
+	"\x10udp_memory_bytes\x18% \x01(\x04R\x0eudpMemoryBytes\x12!\n" +
+	"\fsockets_used\x18& \x01(\x04R\vsocketsUsed\"\x94\x04\n" +
+	"\x10ConntrackMetrics\x12\x18\n" +
+	"\aentries\x18\x01 \x01(\x04R\aentries\x12\x1f\n" +
+	"\vmax_entries\x18\x02 \x01(\x04R\n" +
+	"maxEntries\x12/\n" +
+	"\x13utilization_percent\x18\x03 \x01(\x01R\x12utilizationPercent\x12#\n" +
+	"\rinsert_failed\x18\x04 \x01(\x04R\finsertFailed\x127\n" +
+	"\x18insert_failed_per_second\x18\x05 \x01(\x01R\x15insertFailedPerSecond\x12\x12\n" +
+	"\x04drop\x18\x06 \x01(\x04R\x04drop\x12&\n" +
+	"\x0fdrop_per_second\x18\a \x01(\x01R\rdropPerSecond\x12\x1d\n" +
+	"\n" +
+	"early_drop\x18\b \x01(\x04R\tearlyDrop\x121\n" +
+	"\x15early_drop_per_second\x18\t \x01(\x01R\x12earlyDropPerSecond\x12%\n" +
+	"\x0esearch_restart\x18\n" +
+	" \x01(\x04R\rsearchRestart\x129\n" +
+	"\x19search_restart_per_second\x18\v \x01(\x01R\x16searchRestartPerSecond\x12\x18\n" +
+	"\ainvalid\x18\f \x01(\x04R\ainvalid\x12,\n" +
+	"\x12invalid_per_second\x18\r \x01(\x01R\x10invalidPerSecond\"\xff\x06\n" +
+	"\fKernelLimits\x124\n" +
+	"\x16file_handles_allocated\x18\x01 \x01(\x04R\x14fileHandlesAllocated\x12(\n" +
+	"\x10file_handles_max\x18\x02 \x01(\x04R\x0efileHandlesMax\x129\n" +
+	"\x19file_handles_used_percent\x18\x03 \x01(\x01R\x16fileHandlesUsedPercent\x12\x1c\n" +
+	"\tprocesses\x18\x04 \x01(\x04R\tprocesses\x12\x18\n" +
+	"\athreads\x18\x05 \x01(\x04R\athreads\x12\x17\n" +
+	"\apid_max\x18\x06 \x01(\x04R\x06pidMax\x12(\n" +
+	"\x10pid_used_percent\x18\a \x01(\x01R\x0epidUsedPercent\x12\x1f\n" +
+	"\vthreads_max\x18\b \x01(\x04R\n" +
+	"threadsMax\x120\n" +
+	"\x14threads_used_percent\x18\t \x01(\x01R\x12threadsUsedPercent\x12'\n" +
+	"\x0finotify_scanned\x18\n" +
+	" \x01(\bR\x0einotifyScanned\x12+\n" +
+	"\x11inotify_instances\x18\v \x01(\x04R\x10inotifyInstances\x12'\n" +
+	"\x0finotify_watches\x18\f \x01(\x04R\x0einotifyWatches\x12;\n" +
+	"\x1ainotify_max_user_instances\x18\r \x01(\x04R\x17inotifyMaxUserInstances\x127\n" +
+	"\x18inotify_max_user_watches\x18\x0e \x01(\x04R\x15inotifyMaxUserWatches\x12;\n" +
+	"\x1ainotify_top_user_instances\x18\x0f \x01(\x04R\x17inotifyTopUserInstances\x127\n" +
+	"\x18inotify_top_user_watches\x18\x10 \x01(\x04R\x15inotifyTopUserWatches\x122\n" +
+	"\x15kubepods_pids_current\x18\x11 \x01(\x04R\x13kubepodsPidsCurrent\x12*\n" +
+	"\x11kubepods_pids_max\x18\x12 \x01(\x04R\x0fkubepodsPidsMax\x12;\n" +
+	"\x1akubepods_pids_used_percent\x18\x13 \x01(\x01R\x17kubepodsPidsUsedPercent\"\xec\x04\n" +
+	"\fProcessStats\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12\x12\n" +
+	"\x04ppid\x18\x02 \x01(\x05R\x04ppid\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\acmdline\x18\x04 \x01(\tR\acmdline\x12\x10\n" +
+	"\x03uid\x18\x05 \x01(\rR\x03uid\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\tR\x04user\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\x12\x1f\n" +
+	"\vcreate_time\x18\b \x01(\x03R\n" +
+	"createTime\x12\x1b\n" +
+	"\trss_bytes\x18\t \x01(\x04R\brssBytes\x12\x1b\n" +
+	"\tvms_bytes\x18\n" +
+	" \x01(\x04R\bvmsBytes\x12(\n" +
+	"\x10cpu_time_seconds\x18\v \x01(\x01R\x0ecpuTimeSeconds\x12\x1f\n" +
+	"\vcpu_percent\x18\f \x01(\x01R\n" +
+	"cpuPercent\x12\x18\n" +
+	"\athreads\x18\r \x01(\rR\athreads\x12\x10\n" +
+	"\x03fds\x18\x0e \x01(\rR\x03fds\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x0f \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\x10 \x01(\x04R\n" +
+	"writeBytes\x121\n" +
+	"\x15read_bytes_per_second\x18\x11 \x01(\x01R\x12readBytesPerSecond\x123\n" +
+	"\x16write_bytes_per_second\x18\x12 \x01(\x01R\x13writeBytesPerSecond\x12\x16\n" +
+	"\x06cgroup\x18\x13 \x01(\tR\x06cgroup\x12\x17\n" +
+	"\apod_uid\x18\x14 \x01(\tR\x06podUid\x12!\n" +
+	"\fcontainer_id\x18\x15 \x01(\tR\vcontainerId\"\xef\x01\n" +
 	"\aCpuInfo\x12\x14\n" +
 	"\x05model\x18\x01 \x01(\tR\x05model\x12\x14\n" +
 	"\x05cores\x18\x02 \x01(\x05R\x05cores\x12\x10\n" +
@@ -1056,7 +3392,21 @@ const file_proto_node_metrics_proto_rawDesc = "" +
 	"physicalId\x12\x17\n" +
 	"\acore_id\x18\x06 \x01(\tR\x06coreId\x12\x10\n" +
 	"\x03cpu\x18\a \x01(\x05R\x03cpu\x12\x14\n" +
-	"\x05usage\x18\b \x01(\x01R\x05usage\"\xb2\x03\n" +
+	"\x05usage\x18\b \x01(\x01R\x05usage\x12'\n" +
+	"\x05times\x18\t \x01(\v2\x11.metrics.CpuTimesR\x05times\"\xed\x01\n" +
+	"\bCpuTimes\x12\x12\n" +
+	"\x04user\x18\x01 \x01(\x01R\x04user\x12\x12\n" +
+	"\x04nice\x18\x02 \x01(\x01R\x04nice\x12\x16\n" +
+	"\x06system\x18\x03 \x01(\x01R\x06system\x12\x12\n" +
+	"\x04idle\x18\x04 \x01(\x01R\x04idle\x12\x16\n" +
+	"\x06iowait\x18\x05 \x01(\x01R\x06iowait\x12\x10\n" +
+	"\x03irq\x18\x06 \x01(\x01R\x03irq\x12\x18\n" +
+	"\asoftirq\x18\a \x01(\x01R\asoftirq\x12\x14\n" +
+	"\x05steal\x18\b \x01(\x01R\x05steal\x12\x14\n" +
+	"\x05guest\x18\t \x01(\x01R\x05guest\x12\x1d\n" +
+	"\n" +
+	"guest_nice\x18\n" +
+	" \x01(\x01R\tguestNice\"\x96\x05\n" +
 	"\bNetUsage\x12(\n" +
 	"\x10total_bytes_sent\x18\x01 \x01(\x04R\x0etotalBytesSent\x120\n" +
 	"\x14total_bytes_received\x18\x02 \x01(\x04R\x12totalBytesReceived\x12,\n" +
@@ -1069,7 +3419,11 @@ const file_proto_node_metrics_proto_rawDesc = "" +
 	"\x0etotal_drop_out\x18\b \x01(\x04R\ftotalDropOut\x12)\n" +
 	"\x11total_fifo_err_in\x18\t \x01(\x04R\x0etotalFifoErrIn\x12+\n" +
 	"\x12total_fifo_err_out\x18\n" +
-	" \x01(\x04R\x0ftotalFifoErrOut\"\xbc\x01\n" +
+	" \x01(\x04R\x0ftotalFifoErrOut\x121\n" +
+	"\x15bytes_sent_per_second\x18\v \x01(\x01R\x12bytesSentPerSecond\x129\n" +
+	"\x19bytes_received_per_second\x18\f \x01(\x01R\x16bytesReceivedPerSecond\x125\n" +
+	"\x17packets_sent_per_second\x18\r \x01(\x01R\x14packetsSentPerSecond\x12=\n" +
+	"\x1bpackets_received_per_second\x18\x0e \x01(\x01R\x18packetsReceivedPerSecond\"\xe7\x03\n" +
 	"\tDiskUsage\x12\x16\n" +
 	"\x06device\x18\x01 \x01(\tR\x06device\x12\x1e\n" +
 	"\n" +
@@ -1079,28 +3433,86 @@ const file_proto_node_metrics_proto_rawDesc = "" +
 	"\x05total\x18\x04 \x01(\x04R\x05total\x12\x12\n" +
 	"\x04used\x18\x05 \x01(\x04R\x04used\x12\x12\n" +
 	"\x04free\x18\x06 \x01(\x04R\x04free\x12!\n" +
-	"\fused_percent\x18\a \x01(\x01R\vusedPercent\"\xb3\x01\n" +
+	"\fused_percent\x18\a \x01(\x01R\vusedPercent\x12!\n" +
+	"\finodes_total\x18\b \x01(\x04R\vinodesTotal\x12\x1f\n" +
+	"\vinodes_used\x18\t \x01(\x04R\n" +
+	"inodesUsed\x12\x1f\n" +
+	"\vinodes_free\x18\n" +
+	" \x01(\x04R\n" +
+	"inodesFree\x12.\n" +
+	"\x13inodes_used_percent\x18\v \x01(\x01R\x11inodesUsedPercent\x12!\n" +
+	"\fblock_device\x18\f \x01(\tR\vblockDevice\x12+\n" +
+	"\x11other_mountpoints\x18\r \x03(\tR\x10otherMountpoints\x12!\n" +
+	"\fkubelet_root\x18\x0e \x01(\bR\vkubeletRoot\x12!\n" +
+	"\fruntime_root\x18\x0f \x01(\bR\vruntimeRoot\"\xfb\x02\n" +
 	"\rDiskIOSummary\x12(\n" +
 	"\x10total_read_bytes\x18\x01 \x01(\x04R\x0etotalReadBytes\x12*\n" +
 	"\x11total_write_bytes\x18\x02 \x01(\x04R\x0ftotalWriteBytes\x12$\n" +
 	"\x0etotal_read_ops\x18\x03 \x01(\x04R\ftotalReadOps\x12&\n" +
-	"\x0ftotal_write_ops\x18\x04 \x01(\x04R\rtotalWriteOps\"\x9b\x01\n" +
+	"\x0ftotal_write_ops\x18\x04 \x01(\x04R\rtotalWriteOps\x121\n" +
+	"\x15read_bytes_per_second\x18\x05 \x01(\x01R\x12readBytesPerSecond\x123\n" +
+	"\x16write_bytes_per_second\x18\x06 \x01(\x01R\x13writeBytesPerSecond\x12-\n" +
+	"\x13read_ops_per_second\x18\a \x01(\x01R\x10readOpsPerSecond\x12/\n" +
+	"\x14write_ops_per_second\x18\b \x01(\x01R\x11writeOpsPerSecond\"\x84\a\n" +
+	"\vDiskIOStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fis_partition\x18\x02 \x01(\bR\visPartition\x12\x16\n" +
+	"\x06parent\x18\x03 \x01(\tR\x06parent\x12 \n" +
+	"\vmountpoints\x18\x04 \x03(\tR\vmountpoints\x12,\n" +
+	"\x12counted_in_summary\x18\x05 \x01(\bR\x10countedInSummary\x12\x1d\n" +
+	"\n" +
+	"read_bytes\x18\x06 \x01(\x04R\treadBytes\x12\x1f\n" +
+	"\vwrite_bytes\x18\a \x01(\x04R\n" +
+	"writeBytes\x12\x19\n" +
+	"\bread_ops\x18\b \x01(\x04R\areadOps\x12\x1b\n" +
+	"\twrite_ops\x18\t \x01(\x04R\bwriteOps\x12&\n" +
+	"\x0fmerged_read_ops\x18\n" +
+	" \x01(\x04R\rmergedReadOps\x12(\n" +
+	"\x10merged_write_ops\x18\v \x01(\x04R\x0emergedWriteOps\x12 \n" +
+	"\fread_time_ms\x18\f \x01(\x04R\n" +
+	"readTimeMs\x12\"\n" +
+	"\rwrite_time_ms\x18\r \x01(\x04R\vwriteTimeMs\x12\x1c\n" +
+	"\n" +
+	"io_time_ms\x18\x0e \x01(\x04R\bioTimeMs\x12-\n" +
+	"\x13weighted_io_time_ms\x18\x0f \x01(\x04R\x10weightedIoTimeMs\x12\x1b\n" +
+	"\tin_flight\x18\x10 \x01(\x04R\binFlight\x121\n" +
+	"\x15read_bytes_per_second\x18\x11 \x01(\x01R\x12readBytesPerSecond\x123\n" +
+	"\x16write_bytes_per_second\x18\x12 \x01(\x01R\x13writeBytesPerSecond\x12-\n" +
+	"\x13read_ops_per_second\x18\x13 \x01(\x01R\x10readOpsPerSecond\x12/\n" +
+	"\x14write_ops_per_second\x18\x14 \x01(\x01R\x11writeOpsPerSecond\x12/\n" +
+	"\x13utilization_percent\x18\x15 \x01(\x01R\x12utilizationPercent\x12\x19\n" +
+	"\bawait_ms\x18\x16 \x01(\x01R\aawaitMs\x12\"\n" +
+	"\rread_await_ms\x18\x17 \x01(\x01R\vreadAwaitMs\x12$\n" +
+	"\x0ewrite_await_ms\x18\x18 \x01(\x01R\fwriteAwaitMs\"\xf8\x06\n" +
 	"\rInterfaceStat\x12\x14\n" +
 	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x10\n" +
 	"\x03mtu\x18\x02 \x01(\x05R\x03mtu\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\"\n" +
 	"\fhardwareAddr\x18\x04 \x01(\tR\fhardwareAddr\x12\x14\n" +
 	"\x05flags\x18\x05 \x03(\tR\x05flags\x12\x14\n" +
-	"\x05addrs\x18\x06 \x03(\tR\x05addrs\"\xdb\x01\n" +
-	"\aPsiData\x122\n" +
-	"\x05total\x18\x01 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x05total\x122\n" +
-	"\x05avg10\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\x05avg10\x122\n" +
-	"\x05avg60\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\x05avg60\x124\n" +
-	"\x06avg300\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\x06avg300\"X\n" +
+	"\x05addrs\x18\x06 \x03(\tR\x05addrs\x12\x1d\n" +
+	"\n" +
+	"speed_mbps\x18\a \x01(\x03R\tspeedMbps\x12\x16\n" +
+	"\x06duplex\x18\b \x01(\tR\x06duplex\x12\x1c\n" +
+	"\toperstate\x18\t \x01(\tR\toperstate\x12'\n" +
+	"\x0fcarrier_changes\x18\n" +
+	" \x01(\x04R\x0ecarrierChanges\x12+\n" +
+	"\x11counters_excluded\x18\v \x01(\bR\x10countersExcluded\x12\x1d\n" +
 	"\n" +
-	"PsiMetrics\x12$\n" +
-	"\x04some\x18\x01 \x01(\v2\x10.metrics.PsiDataR\x04some\x12$\n" +
-	"\x04full\x18\x02 \x01(\v2\x10.metrics.PsiDataR\x04fullB\fZ\n" +
+	"bytes_sent\x18\f \x01(\x04R\tbytesSent\x12%\n" +
+	"\x0ebytes_received\x18\r \x01(\x04R\rbytesReceived\x12!\n" +
+	"\fpackets_sent\x18\x0e \x01(\x04R\vpacketsSent\x12)\n" +
+	"\x10packets_received\x18\x0f \x01(\x04R\x0fpacketsReceived\x12\x15\n" +
+	"\x06err_in\x18\x10 \x01(\x04R\x05errIn\x12\x17\n" +
+	"\aerr_out\x18\x11 \x01(\x04R\x06errOut\x12\x17\n" +
+	"\adrop_in\x18\x12 \x01(\x04R\x06dropIn\x12\x19\n" +
+	"\bdrop_out\x18\x13 \x01(\x04R\adropOut\x121\n" +
+	"\x15bytes_sent_per_second\x18\x14 \x01(\x01R\x12bytesSentPerSecond\x129\n" +
+	"\x19bytes_received_per_second\x18\x15 \x01(\x01R\x16bytesReceivedPerSecond\x125\n" +
+	"\x17packets_sent_per_second\x18\x16 \x01(\x01R\x14packetsSentPerSecond\x12=\n" +
+	"\x1bpackets_received_per_second\x18\x17 \x01(\x01R\x18packetsReceivedPerSecond\x12*\n" +
+	"\x11errors_per_second\x18\x18 \x01(\x01R\x0ferrorsPerSecond\x12(\n" +
+	"\x10drops_per_second\x18\x19 \x01(\x01R\x0edropsPerSecondB\fZ\n" +
 	"/proto/genb\x06proto3"
 
 var (
@@ -1115,44 +3527,58 @@ func file_proto_node_metrics_proto_rawDescGZIP() []byte {
 	return file_proto_node_metrics_proto_rawDescData
 }
 
-var file_proto_node_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_node_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_node_metrics_proto_goTypes = []any{
 	(*NodeMetrics)(nil),            // 0: metrics.NodeMetrics
-	(*ProcessMemInfo)(nil),         // 1: metrics.ProcessMemInfo
-	(*CpuInfo)(nil),                // 2: metrics.CpuInfo
-	(*NetUsage)(nil),               // 3: metrics.NetUsage
-	(*DiskUsage)(nil),              // 4: metrics.DiskUsage
-	(*DiskIOSummary)(nil),          // 5: metrics.DiskIOSummary
-	(*InterfaceStat)(nil),          // 6: metrics.InterfaceStat
-	(*PsiData)(nil),                // 7: metrics.PsiData
-	(*PsiMetrics)(nil),             // 8: metrics.PsiMetrics
-	(*wrapperspb.StringValue)(nil), // 9: google.protobuf.StringValue
-	(*wrapperspb.UInt64Value)(nil), // 10: google.protobuf.UInt64Value
-	(*wrapperspb.DoubleValue)(nil), // 11: google.protobuf.DoubleValue
+	(*NodeMemoryMetrics)(nil),      // 1: metrics.NodeMemoryMetrics
+	(*LoadMetrics)(nil),            // 2: metrics.LoadMetrics
+	(*ProcessMemInfo)(nil),         // 3: metrics.ProcessMemInfo
+	(*NetworkStackMetrics)(nil),    // 4: metrics.NetworkStackMetrics
+	(*ConntrackMetrics)(nil),       // 5: metrics.ConntrackMetrics
+	(*KernelLimits)(nil),           // 6: metrics.KernelLimits
+	(*ProcessStats)(nil),           // 7: metrics.ProcessStats
+	(*CpuInfo)(nil),                // 8: metrics.CpuInfo
+	(*CpuTimes)(nil),               // 9: metrics.CpuTimes
+	(*NetUsage)(nil),               // 10: metrics.NetUsage
+	(*DiskUsage)(nil),              // 11: metrics.DiskUsage
+	(*DiskIOSummary)(nil),          // 12: metrics.DiskIOSummary
+	(*DiskIOStats)(nil),            // 13: metrics.DiskIOStats
+	(*InterfaceStat)(nil),          // 14: metrics.InterfaceStat
+	nil,                            // 15: metrics.NodeMetrics.QosPressureEntry
+	(*wrapperspb.StringValue)(nil), // 16: google.protobuf.StringValue
+	(*PsiMetrics)(nil),             // 17: metrics.PsiMetrics
+	(*CgroupPressure)(nil),         // 18: metrics.CgroupPressure
 }
 var file_proto_node_metrics_proto_depIdxs = []int32{
-	9,  // 0: metrics.NodeMetrics.primary_ipv4:type_name -> google.protobuf.StringValue
-	9,  // 1: metrics.NodeMetrics.primary_ipv6:type_name -> google.protobuf.StringValue
-	2,  // 2: metrics.NodeMetrics.cpu_infos:type_name -> metrics.CpuInfo
-	3,  // 3: metrics.NodeMetrics.net_usage:type_name -> metrics.NetUsage
-	1,  // 4: metrics.NodeMetrics.processes_mem_info:type_name -> metrics.ProcessMemInfo
-	4,  // 5: metrics.NodeMetrics.disk_usages:type_name -> metrics.DiskUsage
-	5,  // 6: metrics.NodeMetrics.disk_io_summary:type_name -> metrics.DiskIOSummary
-	8,  // 7: metrics.NodeMetrics.psi_cpu_metrics:type_name -> metrics.PsiMetrics
-	8,  // 8: metrics.NodeMetrics.psi_memory_metrics:type_name -> metrics.PsiMetrics
-	8,  // 9: metrics.NodeMetrics.psi_io_metrics:type_name -> metrics.PsiMetrics
-	6,  // 10: metrics.NodeMetrics.network_interfaces:type_name -> metrics.InterfaceStat
-	10, // 11: metrics.PsiData.total:type_name -> google.protobuf.UInt64Value
-	11, // 12: metrics.PsiData.avg10:type_name -> google.protobuf.DoubleValue
-	11, // 13: metrics.PsiData.avg60:type_name -> google.protobuf.DoubleValue
-	11, // 14: metrics.PsiData.avg300:type_name -> google.protobuf.DoubleValue
-	7,  // 15: metrics.PsiMetrics.some:type_name -> metrics.PsiData
-	7,  // 16: metrics.PsiMetrics.full:type_name -> metrics.PsiData
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	16, // 0: metrics.NodeMetrics.primary_ipv4:type_name -> google.protobuf.StringValue
+	16, // 1: metrics.NodeMetrics.primary_ipv6:type_name -> google.protobuf.StringValue
+	8,  // 2: metrics.NodeMetrics.cpu_infos:type_name -> metrics.CpuInfo
+	10, // 3: metrics.NodeMetrics.net_usage:type_name -> metrics.NetUsage
+	3,  // 4: metrics.NodeMetrics.processes_mem_info:type_name -> metrics.ProcessMemInfo
+	11, // 5: metrics.NodeMetrics.disk_usages:type_name -> metrics.DiskUsage
+	12, // 6: metrics.NodeMetrics.disk_io_summary:type_name -> metrics.DiskIOSummary
+	17, // 7: metrics.NodeMetrics.psi_cpu_metrics:type_name -> metrics.PsiMetrics
+	17, // 8: metrics.NodeMetrics.psi_memory_metrics:type_name -> metrics.PsiMetrics
+	17, // 9: metrics.NodeMetrics.psi_io_metrics:type_name -> metrics.PsiMetrics
+	14, // 10: metrics.NodeMetrics.network_interfaces:type_name -> metrics.InterfaceStat
+	9,  // 11: metrics.NodeMetrics.total_cpu_times:type_name -> metrics.CpuTimes
+	2,  // 12: metrics.NodeMetrics.load_metrics:type_name -> metrics.LoadMetrics
+	1,  // 13: metrics.NodeMetrics.memory_metrics:type_name -> metrics.NodeMemoryMetrics
+	13, // 14: metrics.NodeMetrics.disk_io_stats:type_name -> metrics.DiskIOStats
+	7,  // 15: metrics.NodeMetrics.top_cpu_processes:type_name -> metrics.ProcessStats
+	7,  // 16: metrics.NodeMetrics.top_memory_processes:type_name -> metrics.ProcessStats
+	4,  // 17: metrics.NodeMetrics.network_stack_metrics:type_name -> metrics.NetworkStackMetrics
+	5,  // 18: metrics.NodeMetrics.conntrack_metrics:type_name -> metrics.ConntrackMetrics
+	6,  // 19: metrics.NodeMetrics.kernel_limits:type_name -> metrics.KernelLimits
+	17, // 20: metrics.NodeMetrics.psi_irq_metrics:type_name -> metrics.PsiMetrics
+	15, // 21: metrics.NodeMetrics.qos_pressure:type_name -> metrics.NodeMetrics.QosPressureEntry
+	9,  // 22: metrics.CpuInfo.times:type_name -> metrics.CpuTimes
+	18, // 23: metrics.NodeMetrics.QosPressureEntry.value:type_name -> metrics.CgroupPressure
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_proto_node_metrics_proto_init() }
@@ -1160,13 +3586,14 @@ func file_proto_node_metrics_proto_init() {
 	if File_proto_node_metrics_proto != nil {
 		return
 	}
+	file_proto_psi_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_node_metrics_proto_rawDesc), len(file_proto_node_metrics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
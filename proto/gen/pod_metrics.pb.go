@@ -9,6 +9,7 @@ package gen
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -40,8 +41,35 @@ type PodMetrics struct {
 	Attempt uint32 `protobuf:"varint,7,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	// List of container metrics for each container running inside the pod.
 	ContainerMetrics []*ContainerMetrics `protobuf:"bytes,8,rep,name=container_metrics,json=containerMetrics,proto3" json:"container_metrics,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Pressure stall information of the pod cgroup, unset when per-cgroup PSI is unavailable (cgroup v1).
+	Pressure *CgroupPressure `protobuf:"bytes,9,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Where the pod-level stats come from: "sandbox_stats" (CRI ListPodSandboxStats, which includes the pause
+	// container and the pod overhead) or "containers_sum" (sum of the container metrics, when the runtime does not
+	// implement it).
+	StatsSource string `protobuf:"bytes,10,opt,name=stats_source,json=statsSource,proto3" json:"stats_source,omitempty"`
+	// CPU usage of the pod. usage_cores is computed by the agent over its own collection interval. With
+	// "containers_sum", usage_core_nano_seconds is not monotonic (it drops when a container restarts) and
+	// usage_cores is unset until every container has a rate.
+	CpuMetrics *CpuMetrics `protobuf:"bytes,11,opt,name=cpu_metrics,json=cpuMetrics,proto3" json:"cpu_metrics,omitempty"`
+	// Memory usage of the pod.
+	MemoryMetrics *MemoryMetrics `protobuf:"bytes,12,opt,name=memory_metrics,json=memoryMetrics,proto3" json:"memory_metrics,omitempty"`
+	// Network counters of the pod network namespace, unset when computed from the container metrics.
+	NetworkMetrics *PodNetworkMetrics `protobuf:"bytes,13,opt,name=network_metrics,json=networkMetrics,proto3" json:"network_metrics,omitempty"`
+	// Number of processes in the pod, unset when computed from the container metrics.
+	ProcessCount *wrapperspb.UInt64Value `protobuf:"bytes,14,opt,name=process_count,json=processCount,proto3" json:"process_count,omitempty"`
+	// Labels of the pod sandbox (the pod labels), selected with --label-include and --label-exclude.
+	Labels map[string]string `protobuf:"bytes,15,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations of the pod sandbox (the pod annotations and the kubelet "kubernetes.io/config.*" ones), selected
+	// with --annotation-include and --annotation-exclude.
+	Annotations map[string]string `protobuf:"bytes,16,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Workload owning the pod, derived from its name and labels; unset when it cannot be derived (e.g., bare or static
+	// pods, or custom controllers).
+	Owner *WorkloadOwner `protobuf:"bytes,17,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Application name of the pod, from the first set label among "app.kubernetes.io/name", "app" and "k8s-app".
+	// Derived before the label filters are applied.
+	App           string `protobuf:"bytes,18,opt,name=app,proto3" json:"app,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PodMetrics) Reset() {
@@ -130,11 +158,282 @@ func (x *PodMetrics) GetContainerMetrics() []*ContainerMetrics {
 	return nil
 }
 
+func (x *PodMetrics) GetPressure() *CgroupPressure {
+	if x != nil {
+		return x.Pressure
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetStatsSource() string {
+	if x != nil {
+		return x.StatsSource
+	}
+	return ""
+}
+
+func (x *PodMetrics) GetCpuMetrics() *CpuMetrics {
+	if x != nil {
+		return x.CpuMetrics
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetMemoryMetrics() *MemoryMetrics {
+	if x != nil {
+		return x.MemoryMetrics
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetNetworkMetrics() *PodNetworkMetrics {
+	if x != nil {
+		return x.NetworkMetrics
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetProcessCount() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.ProcessCount
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetOwner() *WorkloadOwner {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *PodMetrics) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+// WorkloadOwner identifies the workload controller owning a pod.
+type WorkloadOwner struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Kind of the workload: "Deployment", "StatefulSet", "DaemonSet" or "Job".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Name of the workload, in the namespace of the pod.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadOwner) Reset() {
+	*x = WorkloadOwner{}
+	mi := &file_proto_pod_metrics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadOwner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadOwner) ProtoMessage() {}
+
+func (x *WorkloadOwner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_metrics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadOwner.ProtoReflect.Descriptor instead.
+func (*WorkloadOwner) Descriptor() ([]byte, []int) {
+	return file_proto_pod_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WorkloadOwner) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *WorkloadOwner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// PodNetworkMetrics reports the cumulative network counters of the interfaces of a pod network namespace.
+type PodNetworkMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection timestamp in nanoseconds since epoch.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Counters of the default interface of the pod (usually "eth0").
+	DefaultInterface *PodInterfaceStats `protobuf:"bytes,2,opt,name=default_interface,json=defaultInterface,proto3" json:"default_interface,omitempty"`
+	// Counters of the other interfaces of the pod (e.g., additional Multus interfaces).
+	Interfaces    []*PodInterfaceStats `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodNetworkMetrics) Reset() {
+	*x = PodNetworkMetrics{}
+	mi := &file_proto_pod_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodNetworkMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodNetworkMetrics) ProtoMessage() {}
+
+func (x *PodNetworkMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodNetworkMetrics.ProtoReflect.Descriptor instead.
+func (*PodNetworkMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_pod_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PodNetworkMetrics) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PodNetworkMetrics) GetDefaultInterface() *PodInterfaceStats {
+	if x != nil {
+		return x.DefaultInterface
+	}
+	return nil
+}
+
+func (x *PodNetworkMetrics) GetInterfaces() []*PodInterfaceStats {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+// PodInterfaceStats reports the cumulative counters of a network interface of a pod.
+type PodInterfaceStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the interface.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Bytes received.
+	RxBytes *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=rx_bytes,json=rxBytes,proto3" json:"rx_bytes,omitempty"`
+	// Receive errors.
+	RxErrors *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	// Bytes transmitted.
+	TxBytes *wrapperspb.UInt64Value `protobuf:"bytes,4,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// Transmit errors.
+	TxErrors      *wrapperspb.UInt64Value `protobuf:"bytes,5,opt,name=tx_errors,json=txErrors,proto3" json:"tx_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodInterfaceStats) Reset() {
+	*x = PodInterfaceStats{}
+	mi := &file_proto_pod_metrics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodInterfaceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodInterfaceStats) ProtoMessage() {}
+
+func (x *PodInterfaceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_pod_metrics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodInterfaceStats.ProtoReflect.Descriptor instead.
+func (*PodInterfaceStats) Descriptor() ([]byte, []int) {
+	return file_proto_pod_metrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PodInterfaceStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PodInterfaceStats) GetRxBytes() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RxBytes
+	}
+	return nil
+}
+
+func (x *PodInterfaceStats) GetRxErrors() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.RxErrors
+	}
+	return nil
+}
+
+func (x *PodInterfaceStats) GetTxBytes() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.TxBytes
+	}
+	return nil
+}
+
+func (x *PodInterfaceStats) GetTxErrors() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.TxErrors
+	}
+	return nil
+}
+
 var File_proto_pod_metrics_proto protoreflect.FileDescriptor
 
 const file_proto_pod_metrics_proto_rawDesc = "" +
 	"\n" +
-	"\x17proto/pod_metrics.proto\x12\ametrics\x1a\x1dproto/container_metrics.proto\"\xf7\x01\n" +
+	"\x17proto/pod_metrics.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1dproto/container_metrics.proto\x1a\x0fproto/psi.proto\"\x88\a\n" +
 	"\n" +
 	"PodMetrics\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
@@ -145,7 +444,40 @@ const file_proto_pod_metrics_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x14\n" +
 	"\x05state\x18\x06 \x01(\tR\x05state\x12\x18\n" +
 	"\aattempt\x18\a \x01(\rR\aattempt\x12F\n" +
-	"\x11container_metrics\x18\b \x03(\v2\x19.metrics.ContainerMetricsR\x10containerMetricsB\fZ\n" +
+	"\x11container_metrics\x18\b \x03(\v2\x19.metrics.ContainerMetricsR\x10containerMetrics\x123\n" +
+	"\bpressure\x18\t \x01(\v2\x17.metrics.CgroupPressureR\bpressure\x12!\n" +
+	"\fstats_source\x18\n" +
+	" \x01(\tR\vstatsSource\x124\n" +
+	"\vcpu_metrics\x18\v \x01(\v2\x13.metrics.CpuMetricsR\n" +
+	"cpuMetrics\x12=\n" +
+	"\x0ememory_metrics\x18\f \x01(\v2\x16.metrics.MemoryMetricsR\rmemoryMetrics\x12C\n" +
+	"\x0fnetwork_metrics\x18\r \x01(\v2\x1a.metrics.PodNetworkMetricsR\x0enetworkMetrics\x12A\n" +
+	"\rprocess_count\x18\x0e \x01(\v2\x1c.google.protobuf.UInt64ValueR\fprocessCount\x127\n" +
+	"\x06labels\x18\x0f \x03(\v2\x1f.metrics.PodMetrics.LabelsEntryR\x06labels\x12F\n" +
+	"\vannotations\x18\x10 \x03(\v2$.metrics.PodMetrics.AnnotationsEntryR\vannotations\x12,\n" +
+	"\x05owner\x18\x11 \x01(\v2\x16.metrics.WorkloadOwnerR\x05owner\x12\x10\n" +
+	"\x03app\x18\x12 \x01(\tR\x03app\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
+	"\rWorkloadOwner\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xb6\x01\n" +
+	"\x11PodNetworkMetrics\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12G\n" +
+	"\x11default_interface\x18\x02 \x01(\v2\x1a.metrics.PodInterfaceStatsR\x10defaultInterface\x12:\n" +
+	"\n" +
+	"interfaces\x18\x03 \x03(\v2\x1a.metrics.PodInterfaceStatsR\n" +
+	"interfaces\"\x8f\x02\n" +
+	"\x11PodInterfaceStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\brx_bytes\x18\x02 \x01(\v2\x1c.google.protobuf.UInt64ValueR\arxBytes\x129\n" +
+	"\trx_errors\x18\x03 \x01(\v2\x1c.google.protobuf.UInt64ValueR\brxErrors\x127\n" +
+	"\btx_bytes\x18\x04 \x01(\v2\x1c.google.protobuf.UInt64ValueR\atxBytes\x129\n" +
+	"\ttx_errors\x18\x05 \x01(\v2\x1c.google.protobuf.UInt64ValueR\btxErrorsB\fZ\n" +
 	"/proto/genb\x06proto3"
 
 var (
@@ -160,18 +492,41 @@ func file_proto_pod_metrics_proto_rawDescGZIP() []byte {
 	return file_proto_pod_metrics_proto_rawDescData
 }
 
-var file_proto_pod_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_proto_pod_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_pod_metrics_proto_goTypes = []any{
-	(*PodMetrics)(nil),       // 0: metrics.PodMetrics
-	(*ContainerMetrics)(nil), // 1: metrics.ContainerMetrics
+	(*PodMetrics)(nil),             // 0: metrics.PodMetrics
+	(*WorkloadOwner)(nil),          // 1: metrics.WorkloadOwner
+	(*PodNetworkMetrics)(nil),      // 2: metrics.PodNetworkMetrics
+	(*PodInterfaceStats)(nil),      // 3: metrics.PodInterfaceStats
+	nil,                            // 4: metrics.PodMetrics.LabelsEntry
+	nil,                            // 5: metrics.PodMetrics.AnnotationsEntry
+	(*ContainerMetrics)(nil),       // 6: metrics.ContainerMetrics
+	(*CgroupPressure)(nil),         // 7: metrics.CgroupPressure
+	(*CpuMetrics)(nil),             // 8: metrics.CpuMetrics
+	(*MemoryMetrics)(nil),          // 9: metrics.MemoryMetrics
+	(*wrapperspb.UInt64Value)(nil), // 10: google.protobuf.UInt64Value
 }
 var file_proto_pod_metrics_proto_depIdxs = []int32{
-	1, // 0: metrics.PodMetrics.container_metrics:type_name -> metrics.ContainerMetrics
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: metrics.PodMetrics.container_metrics:type_name -> metrics.ContainerMetrics
+	7,  // 1: metrics.PodMetrics.pressure:type_name -> metrics.CgroupPressure
+	8,  // 2: metrics.PodMetrics.cpu_metrics:type_name -> metrics.CpuMetrics
+	9,  // 3: metrics.PodMetrics.memory_metrics:type_name -> metrics.MemoryMetrics
+	2,  // 4: metrics.PodMetrics.network_metrics:type_name -> metrics.PodNetworkMetrics
+	10, // 5: metrics.PodMetrics.process_count:type_name -> google.protobuf.UInt64Value
+	4,  // 6: metrics.PodMetrics.labels:type_name -> metrics.PodMetrics.LabelsEntry
+	5,  // 7: metrics.PodMetrics.annotations:type_name -> metrics.PodMetrics.AnnotationsEntry
+	1,  // 8: metrics.PodMetrics.owner:type_name -> metrics.WorkloadOwner
+	3,  // 9: metrics.PodNetworkMetrics.default_interface:type_name -> metrics.PodInterfaceStats
+	3,  // 10: metrics.PodNetworkMetrics.interfaces:type_name -> metrics.PodInterfaceStats
+	10, // 11: metrics.PodInterfaceStats.rx_bytes:type_name -> google.protobuf.UInt64Value
+	10, // 12: metrics.PodInterfaceStats.rx_errors:type_name -> google.protobuf.UInt64Value
+	10, // 13: metrics.PodInterfaceStats.tx_bytes:type_name -> google.protobuf.UInt64Value
+	10, // 14: metrics.PodInterfaceStats.tx_errors:type_name -> google.protobuf.UInt64Value
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_proto_pod_metrics_proto_init() }
@@ -180,13 +535,14 @@ func file_proto_pod_metrics_proto_init() {
 		return
 	}
 	file_proto_container_metrics_proto_init()
+	file_proto_psi_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_pod_metrics_proto_rawDesc), len(file_proto_pod_metrics_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,301 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v3.21.12
+// source: proto/psi.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PsiData captures pressure stall information (PSI) for a specific resource type
+// such as CPU, memory, or I/O. It quantifies how often and how severely the system
+// experienced delays due to contention for that resource.
+type PsiData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cumulative time (in microseconds) that tasks have been stalled due to resource pressure since system boot.
+	Total *wrapperspb.UInt64Value `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	// Rolling average of stall time over the last 10 seconds, expressed as a percentage (0.0–100.0).
+	Avg10 *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=avg10,proto3" json:"avg10,omitempty"`
+	// Rolling average of stall time over the last 60 seconds, expressed as a percentage (0.0–100.0).
+	Avg60 *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=avg60,proto3" json:"avg60,omitempty"`
+	// Rolling average of stall time over the last 300 seconds, expressed as a percentage (0.0–100.0).
+	Avg300        *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=avg300,proto3" json:"avg300,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PsiData) Reset() {
+	*x = PsiData{}
+	mi := &file_proto_psi_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PsiData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsiData) ProtoMessage() {}
+
+func (x *PsiData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_psi_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsiData.ProtoReflect.Descriptor instead.
+func (*PsiData) Descriptor() ([]byte, []int) {
+	return file_proto_psi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PsiData) GetTotal() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *PsiData) GetAvg10() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Avg10
+	}
+	return nil
+}
+
+func (x *PsiData) GetAvg60() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Avg60
+	}
+	return nil
+}
+
+func (x *PsiData) GetAvg300() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Avg300
+	}
+	return nil
+}
+
+// PsiMetrics reports pressure stall metrics for a resource in two distinct conditions:
+// - 'some': partial contention (some tasks stalled)
+// - 'full': total contention (all tasks stalled)
+type PsiMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Represents partial pressure — periods when at least one task was stalled, but others may have progressed.
+	Some *PsiData `protobuf:"bytes,1,opt,name=some,proto3" json:"some,omitempty"`
+	// Represents full pressure — periods when all non-idle tasks were stalled and no useful work could be done.
+	Full          *PsiData `protobuf:"bytes,2,opt,name=full,proto3" json:"full,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PsiMetrics) Reset() {
+	*x = PsiMetrics{}
+	mi := &file_proto_psi_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PsiMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsiMetrics) ProtoMessage() {}
+
+func (x *PsiMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_psi_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PsiMetrics.ProtoReflect.Descriptor instead.
+func (*PsiMetrics) Descriptor() ([]byte, []int) {
+	return file_proto_psi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PsiMetrics) GetSome() *PsiData {
+	if x != nil {
+		return x.Some
+	}
+	return nil
+}
+
+func (x *PsiMetrics) GetFull() *PsiData {
+	if x != nil {
+		return x.Full
+	}
+	return nil
+}
+
+// CgroupPressure reports the pressure stall information of a cgroup v2 (cpu.pressure,
+// memory.pressure and io.pressure), i.e. the stalls of the tasks of the cgroup and its descendants.
+type CgroupPressure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pressure stall information for CPU-related resource contention.
+	Cpu *PsiMetrics `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	// Pressure stall information for memory-related resource contention.
+	Memory *PsiMetrics `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Pressure stall information for I/O-related resource contention.
+	Io            *PsiMetrics `protobuf:"bytes,3,opt,name=io,proto3" json:"io,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CgroupPressure) Reset() {
+	*x = CgroupPressure{}
+	mi := &file_proto_psi_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CgroupPressure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupPressure) ProtoMessage() {}
+
+func (x *CgroupPressure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_psi_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupPressure.ProtoReflect.Descriptor instead.
+func (*CgroupPressure) Descriptor() ([]byte, []int) {
+	return file_proto_psi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CgroupPressure) GetCpu() *PsiMetrics {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *CgroupPressure) GetMemory() *PsiMetrics {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *CgroupPressure) GetIo() *PsiMetrics {
+	if x != nil {
+		return x.Io
+	}
+	return nil
+}
+
+var File_proto_psi_proto protoreflect.FileDescriptor
+
+const file_proto_psi_proto_rawDesc = "" +
+	"\n" +
+	"\x0fproto/psi.proto\x12\ametrics\x1a\x1egoogle/protobuf/wrappers.proto\"\xdb\x01\n" +
+	"\aPsiData\x122\n" +
+	"\x05total\x18\x01 \x01(\v2\x1c.google.protobuf.UInt64ValueR\x05total\x122\n" +
+	"\x05avg10\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\x05avg10\x122\n" +
+	"\x05avg60\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\x05avg60\x124\n" +
+	"\x06avg300\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\x06avg300\"X\n" +
+	"\n" +
+	"PsiMetrics\x12$\n" +
+	"\x04some\x18\x01 \x01(\v2\x10.metrics.PsiDataR\x04some\x12$\n" +
+	"\x04full\x18\x02 \x01(\v2\x10.metrics.PsiDataR\x04full\"\x89\x01\n" +
+	"\x0eCgroupPressure\x12%\n" +
+	"\x03cpu\x18\x01 \x01(\v2\x13.metrics.PsiMetricsR\x03cpu\x12+\n" +
+	"\x06memory\x18\x02 \x01(\v2\x13.metrics.PsiMetricsR\x06memory\x12#\n" +
+	"\x02io\x18\x03 \x01(\v2\x13.metrics.PsiMetricsR\x02ioB\fZ\n" +
+	"/proto/genb\x06proto3"
+
+var (
+	file_proto_psi_proto_rawDescOnce sync.Once
+	file_proto_psi_proto_rawDescData []byte
+)
+
+func file_proto_psi_proto_rawDescGZIP() []byte {
+	file_proto_psi_proto_rawDescOnce.Do(func() {
+		file_proto_psi_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_psi_proto_rawDesc), len(file_proto_psi_proto_rawDesc)))
+	})
+	return file_proto_psi_proto_rawDescData
+}
+
+var file_proto_psi_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_proto_psi_proto_goTypes = []any{
+	(*PsiData)(nil),                // 0: metrics.PsiData
+	(*PsiMetrics)(nil),             // 1: metrics.PsiMetrics
+	(*CgroupPressure)(nil),         // 2: metrics.CgroupPressure
+	(*wrapperspb.UInt64Value)(nil), // 3: google.protobuf.UInt64Value
+	(*wrapperspb.DoubleValue)(nil), // 4: google.protobuf.DoubleValue
+}
+var file_proto_psi_proto_depIdxs = []int32{
+	3, // 0: metrics.PsiData.total:type_name -> google.protobuf.UInt64Value
+	4, // 1: metrics.PsiData.avg10:type_name -> google.protobuf.DoubleValue
+	4, // 2: metrics.PsiData.avg60:type_name -> google.protobuf.DoubleValue
+	4, // 3: metrics.PsiData.avg300:type_name -> google.protobuf.DoubleValue
+	0, // 4: metrics.PsiMetrics.some:type_name -> metrics.PsiData
+	0, // 5: metrics.PsiMetrics.full:type_name -> metrics.PsiData
+	1, // 6: metrics.CgroupPressure.cpu:type_name -> metrics.PsiMetrics
+	1, // 7: metrics.CgroupPressure.memory:type_name -> metrics.PsiMetrics
+	1, // 8: metrics.CgroupPressure.io:type_name -> metrics.PsiMetrics
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_proto_psi_proto_init() }
+func file_proto_psi_proto_init() {
+	if File_proto_psi_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_psi_proto_rawDesc), len(file_proto_psi_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_psi_proto_goTypes,
+		DependencyIndexes: file_proto_psi_proto_depIdxs,
+		MessageInfos:      file_proto_psi_proto_msgTypes,
+	}.Build()
+	File_proto_psi_proto = out.File
+	file_proto_psi_proto_goTypes = nil
+	file_proto_psi_proto_depIdxs = nil
+}